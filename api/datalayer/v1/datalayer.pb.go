@@ -315,6 +315,8 @@ func (JoinType) EnumDescriptor() ([]byte, []int) {
 	return file_datalayer_proto_rawDescGZIP(), []int{4}
 }
 
+// Deprecated: use cache_namespace instead. Legacy values map to the namespace named after the
+// lowercased enum value (e.g. PERMISSION -> "permission").
 type RedisDB int32
 
 const (
@@ -376,6 +378,56 @@ func (RedisDB) EnumDescriptor() ([]byte, []int) {
 	return file_datalayer_proto_rawDescGZIP(), []int{5}
 }
 
+// Read consistency for non-transactional reads on databases with replicas configured.
+type Consistency int32
+
+const (
+	Consistency_CONSISTENCY_UNSPECIFIED Consistency = 0 // Same as EVENTUAL
+	Consistency_EVENTUAL                Consistency = 1 // Read from a healthy replica, falling back to the primary
+	Consistency_STRONG                  Consistency = 2 // Always read from the primary
+)
+
+// Enum value maps for Consistency.
+var (
+	Consistency_name = map[int32]string{
+		0: "CONSISTENCY_UNSPECIFIED",
+		1: "EVENTUAL",
+		2: "STRONG",
+	}
+	Consistency_value = map[string]int32{
+		"CONSISTENCY_UNSPECIFIED": 0,
+		"EVENTUAL":                1,
+		"STRONG":                  2,
+	}
+)
+
+func (x Consistency) Enum() *Consistency {
+	p := new(Consistency)
+	*p = x
+	return p
+}
+
+func (x Consistency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Consistency) Descriptor() protoreflect.EnumDescriptor {
+	return file_datalayer_proto_enumTypes[6].Descriptor()
+}
+
+func (Consistency) Type() protoreflect.EnumType {
+	return &file_datalayer_proto_enumTypes[6]
+}
+
+func (x Consistency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Consistency.Descriptor instead.
+func (Consistency) EnumDescriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{6}
+}
+
 // Standard SQL aggregate functions
 type Aggregation_Function int32
 
@@ -419,11 +471,11 @@ func (x Aggregation_Function) String() string {
 }
 
 func (Aggregation_Function) Descriptor() protoreflect.EnumDescriptor {
-	return file_datalayer_proto_enumTypes[6].Descriptor()
+	return file_datalayer_proto_enumTypes[7].Descriptor()
 }
 
 func (Aggregation_Function) Type() protoreflect.EnumType {
-	return &file_datalayer_proto_enumTypes[6]
+	return &file_datalayer_proto_enumTypes[7]
 }
 
 func (x Aggregation_Function) Number() protoreflect.EnumNumber {
@@ -1079,13 +1131,25 @@ type QueryRequest struct {
 	TransactionId string `protobuf:"bytes,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	// Optional: Set to true to request the total count matching the where clause (ignoring limit/offset).
 	RequestTotalCount bool `protobuf:"varint,12,opt,name=request_total_count,json=requestTotalCount,proto3" json:"request_total_count,omitempty"`
-	// Optional: If set, indicates a desire to cache the query result based on a single equality condition
-	// on this field. The caching layer will validate if the where_clause matches this expectation.
-	CacheByField string `protobuf:"bytes,13,opt,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
-	// Optional: Specify cache TTL for this query, defaults to 24h if not set or invalid.
+	// Optional: If set, indicates a desire to cache the query result based on equality conditions on these
+	// fields. A single field expects a bare condition, multiple fields expect an AND-nested clause of
+	// equality conditions covering exactly these fields. The caching layer will validate if the
+	// where_clause matches this expectation.
+	CacheByField []string `protobuf:"bytes,13,rep,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
+	// Optional: Specify cache TTL for this query, defaults to the namespace TTL if not set or invalid.
 	CacheTtlSeconds int64 `protobuf:"varint,14,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`
-	// Optional: Specifies the Redis database number to use for caching this query.
-	RedisDb       RedisDB `protobuf:"varint,15,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
+	// Deprecated: use cache_namespace instead.
+	//
+	// Deprecated: Marked as deprecated in datalayer.proto.
+	RedisDb RedisDB `protobuf:"varint,15,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
+	// Optional: Set to true to also cache empty results (negative caching). Off by default.
+	CacheEmpty bool `protobuf:"varint,16,opt,name=cache_empty,json=cacheEmpty,proto3" json:"cache_empty,omitempty"`
+	// Optional: TTL for cached empty results, defaults to 1m if not set or invalid.
+	EmptyCacheTtlSeconds int64 `protobuf:"varint,17,opt,name=empty_cache_ttl_seconds,json=emptyCacheTtlSeconds,proto3" json:"empty_cache_ttl_seconds,omitempty"`
+	// Optional: Name of the cache namespace configured on the server to use for caching this query.
+	CacheNamespace string `protobuf:"bytes,18,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	// Optional: Set to STRONG to read from the primary instead of a replica. Ignored within a transaction.
+	Consistency   Consistency `protobuf:"varint,19,opt,name=consistency,proto3,enum=datalayer.v1.Consistency" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1204,11 +1268,11 @@ func (x *QueryRequest) GetRequestTotalCount() bool {
 	return false
 }
 
-func (x *QueryRequest) GetCacheByField() string {
+func (x *QueryRequest) GetCacheByField() []string {
 	if x != nil {
 		return x.CacheByField
 	}
-	return ""
+	return nil
 }
 
 func (x *QueryRequest) GetCacheTtlSeconds() int64 {
@@ -1218,6 +1282,7 @@ func (x *QueryRequest) GetCacheTtlSeconds() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in datalayer.proto.
 func (x *QueryRequest) GetRedisDb() RedisDB {
 	if x != nil {
 		return x.RedisDb
@@ -1225,6 +1290,34 @@ func (x *QueryRequest) GetRedisDb() RedisDB {
 	return RedisDB_UNSPECIFIED
 }
 
+func (x *QueryRequest) GetCacheEmpty() bool {
+	if x != nil {
+		return x.CacheEmpty
+	}
+	return false
+}
+
+func (x *QueryRequest) GetEmptyCacheTtlSeconds() int64 {
+	if x != nil {
+		return x.EmptyCacheTtlSeconds
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_CONSISTENCY_UNSPECIFIED
+}
+
 type QueryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Rows  []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"` // The resulting data rows
@@ -1291,8 +1384,19 @@ type InsertRequest struct {
 	ConflictColumns []string `protobuf:"bytes,5,rep,name=conflict_columns,json=conflictColumns,proto3" json:"conflict_columns,omitempty"`
 	// required when on_conflict is UPSERT. Specifies the column to be updated when a conflict occurs.
 	UpdateColumns []string `protobuf:"bytes,6,rep,name=update_columns,json=updateColumns,proto3" json:"update_columns,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Optional: cached entries keyed by these fields' values in the inserted rows are invalidated.
+	CacheByField []string `protobuf:"bytes,7,rep,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
+	// Deprecated: use cache_namespace instead.
+	//
+	// Deprecated: Marked as deprecated in datalayer.proto.
+	RedisDb RedisDB `protobuf:"varint,8,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
+	// Optional
+	CacheNamespace string `protobuf:"bytes,9,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	// Optional: repeated requests with the same key return the stored result instead of executing again,
+	// and the server may retry the insert on transient connection errors. Ignored within a transaction.
+	IdempotencyKey string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InsertRequest) Reset() {
@@ -1367,6 +1471,35 @@ func (x *InsertRequest) GetUpdateColumns() []string {
 	return nil
 }
 
+func (x *InsertRequest) GetCacheByField() []string {
+	if x != nil {
+		return x.CacheByField
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in datalayer.proto.
+func (x *InsertRequest) GetRedisDb() RedisDB {
+	if x != nil {
+		return x.RedisDb
+	}
+	return RedisDB_UNSPECIFIED
+}
+
+func (x *InsertRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *InsertRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // --- Update ---
 type UpdateRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -1375,12 +1508,19 @@ type UpdateRequest struct {
 	WhereClause *WhereClause           `protobuf:"bytes,3,opt,name=where_clause,json=whereClause,proto3" json:"where_clause,omitempty"` // Conditions to match rows for update (required)
 	// Optional: Transaction ID if part of a transaction
 	TransactionId string `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Optional: same fields as QueryRequest.cache_by_field, used to invalidate the cached entry.
+	CacheByField []string `protobuf:"bytes,5,rep,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
+	// Deprecated: use cache_namespace instead.
+	//
+	// Deprecated: Marked as deprecated in datalayer.proto.
+	RedisDb RedisDB `protobuf:"varint,6,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
 	// Optional
-	CacheByField string `protobuf:"bytes,5,opt,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
-	// Optional
-	RedisDb       RedisDB `protobuf:"varint,6,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CacheNamespace string `protobuf:"bytes,7,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	// Optional: repeated requests with the same key return the stored result instead of executing again,
+	// and the server may retry the update on transient connection errors. Ignored within a transaction.
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
@@ -1441,13 +1581,14 @@ func (x *UpdateRequest) GetTransactionId() string {
 	return ""
 }
 
-func (x *UpdateRequest) GetCacheByField() string {
+func (x *UpdateRequest) GetCacheByField() []string {
 	if x != nil {
 		return x.CacheByField
 	}
-	return ""
+	return nil
 }
 
+// Deprecated: Marked as deprecated in datalayer.proto.
 func (x *UpdateRequest) GetRedisDb() RedisDB {
 	if x != nil {
 		return x.RedisDb
@@ -1455,6 +1596,20 @@ func (x *UpdateRequest) GetRedisDb() RedisDB {
 	return RedisDB_UNSPECIFIED
 }
 
+func (x *UpdateRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // --- Delete ---
 type DeleteRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -1462,12 +1617,19 @@ type DeleteRequest struct {
 	WhereClause *WhereClause           `protobuf:"bytes,2,opt,name=where_clause,json=whereClause,proto3" json:"where_clause,omitempty"` // Conditions to match rows for deletion (required)
 	// Optional: Transaction ID if part of a transaction
 	TransactionId string `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Optional: same fields as QueryRequest.cache_by_field, used to invalidate the cached entry.
+	CacheByField []string `protobuf:"bytes,4,rep,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
+	// Deprecated: use cache_namespace instead.
+	//
+	// Deprecated: Marked as deprecated in datalayer.proto.
+	RedisDb RedisDB `protobuf:"varint,5,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
 	// Optional
-	CacheByField string `protobuf:"bytes,4,opt,name=cache_by_field,json=cacheByField,proto3" json:"cache_by_field,omitempty"`
-	// Optional
-	RedisDb       RedisDB `protobuf:"varint,5,opt,name=redis_db,json=redisDb,proto3,enum=datalayer.v1.RedisDB" json:"redis_db,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CacheNamespace string `protobuf:"bytes,6,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	// Optional: repeated requests with the same key return the stored result instead of executing again,
+	// and the server may retry the delete on transient connection errors. Ignored within a transaction.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeleteRequest) Reset() {
@@ -1521,13 +1683,14 @@ func (x *DeleteRequest) GetTransactionId() string {
 	return ""
 }
 
-func (x *DeleteRequest) GetCacheByField() string {
+func (x *DeleteRequest) GetCacheByField() []string {
 	if x != nil {
 		return x.CacheByField
 	}
-	return ""
+	return nil
 }
 
+// Deprecated: Marked as deprecated in datalayer.proto.
 func (x *DeleteRequest) GetRedisDb() RedisDB {
 	if x != nil {
 		return x.RedisDb
@@ -1535,10 +1698,27 @@ func (x *DeleteRequest) GetRedisDb() RedisDB {
 	return RedisDB_UNSPECIFIED
 }
 
+func (x *DeleteRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // --- Common Mutation Response ---
 type MutationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"` // Number of rows affected by Insert, Update, or Delete
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"` // Number of rows affected by Insert, Update, or Delete
+	// Auto-increment IDs of inserted rows, in request order. Only set for plain inserts
+	// (on_conflict FAIL) on drivers that report the last insert id (mysql, sqlite).
+	GeneratedIds  []int64 `protobuf:"varint,2,rep,packed,name=generated_ids,json=generatedIds,proto3" json:"generated_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1580,6 +1760,13 @@ func (x *MutationResponse) GetAffectedRows() int64 {
 	return 0
 }
 
+func (x *MutationResponse) GetGeneratedIds() []int64 {
+	if x != nil {
+		return x.GeneratedIds
+	}
+	return nil
+}
+
 // --- Transaction ---
 type BeginTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1714,6 +1901,146 @@ func (x *TransactionRequest) GetTransactionId() string {
 }
 
 // --- Metadata ---
+type ListDatabasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabasesRequest) Reset() {
+	*x = ListDatabasesRequest{}
+	mi := &file_datalayer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabasesRequest) ProtoMessage() {}
+
+func (x *ListDatabasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabasesRequest.ProtoReflect.Descriptor instead.
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{19}
+}
+
+type DatabaseInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`                                  // mysql, postgres or sqlite
+	ReplicaCount  int32                  `protobuf:"varint,3,opt,name=replica_count,json=replicaCount,proto3" json:"replica_count,omitempty"` // Number of configured read replicas
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseInfo) Reset() {
+	*x = DatabaseInfo{}
+	mi := &file_datalayer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseInfo) ProtoMessage() {}
+
+func (x *DatabaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseInfo.ProtoReflect.Descriptor instead.
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DatabaseInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseInfo) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *DatabaseInfo) GetReplicaCount() int32 {
+	if x != nil {
+		return x.ReplicaCount
+	}
+	return 0
+}
+
+type ListDatabasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*DatabaseInfo        `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabasesResponse) Reset() {
+	*x = ListDatabasesResponse{}
+	mi := &file_datalayer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabasesResponse) ProtoMessage() {}
+
+func (x *ListDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDatabasesResponse) GetDatabases() []*DatabaseInfo {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
 type ListTablesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional: filter by schema name if applicable
@@ -1724,7 +2051,7 @@ type ListTablesRequest struct {
 
 func (x *ListTablesRequest) Reset() {
 	*x = ListTablesRequest{}
-	mi := &file_datalayer_proto_msgTypes[19]
+	mi := &file_datalayer_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1736,7 +2063,7 @@ func (x *ListTablesRequest) String() string {
 func (*ListTablesRequest) ProtoMessage() {}
 
 func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[19]
+	mi := &file_datalayer_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1749,7 +2076,7 @@ func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
 func (*ListTablesRequest) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{19}
+	return file_datalayer_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListTablesRequest) GetDbName() string {
@@ -1768,7 +2095,7 @@ type ListTablesResponse struct {
 
 func (x *ListTablesResponse) Reset() {
 	*x = ListTablesResponse{}
-	mi := &file_datalayer_proto_msgTypes[20]
+	mi := &file_datalayer_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1780,7 +2107,7 @@ func (x *ListTablesResponse) String() string {
 func (*ListTablesResponse) ProtoMessage() {}
 
 func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[20]
+	mi := &file_datalayer_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +2120,7 @@ func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
 func (*ListTablesResponse) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{20}
+	return file_datalayer_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListTablesResponse) GetTableNames() []string {
@@ -1806,13 +2133,14 @@ func (x *ListTablesResponse) GetTableNames() []string {
 type DescribeTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Table         *TableSchema           `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"` // Required
+	Consistency   Consistency            `protobuf:"varint,2,opt,name=consistency,proto3,enum=datalayer.v1.Consistency" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DescribeTableRequest) Reset() {
 	*x = DescribeTableRequest{}
-	mi := &file_datalayer_proto_msgTypes[21]
+	mi := &file_datalayer_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1824,7 +2152,7 @@ func (x *DescribeTableRequest) String() string {
 func (*DescribeTableRequest) ProtoMessage() {}
 
 func (x *DescribeTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[21]
+	mi := &file_datalayer_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,7 +2165,7 @@ func (x *DescribeTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeTableRequest.ProtoReflect.Descriptor instead.
 func (*DescribeTableRequest) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{21}
+	return file_datalayer_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DescribeTableRequest) GetTable() *TableSchema {
@@ -1847,6 +2175,13 @@ func (x *DescribeTableRequest) GetTable() *TableSchema {
 	return nil
 }
 
+func (x *DescribeTableRequest) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_CONSISTENCY_UNSPECIFIED
+}
+
 type ColumnMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // Column name
@@ -1861,7 +2196,7 @@ type ColumnMetadata struct {
 
 func (x *ColumnMetadata) Reset() {
 	*x = ColumnMetadata{}
-	mi := &file_datalayer_proto_msgTypes[22]
+	mi := &file_datalayer_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1873,7 +2208,7 @@ func (x *ColumnMetadata) String() string {
 func (*ColumnMetadata) ProtoMessage() {}
 
 func (x *ColumnMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[22]
+	mi := &file_datalayer_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1886,7 +2221,7 @@ func (x *ColumnMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnMetadata.ProtoReflect.Descriptor instead.
 func (*ColumnMetadata) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{22}
+	return file_datalayer_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ColumnMetadata) GetName() string {
@@ -1944,7 +2279,7 @@ type IndexMetadata struct {
 
 func (x *IndexMetadata) Reset() {
 	*x = IndexMetadata{}
-	mi := &file_datalayer_proto_msgTypes[23]
+	mi := &file_datalayer_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1956,7 +2291,7 @@ func (x *IndexMetadata) String() string {
 func (*IndexMetadata) ProtoMessage() {}
 
 func (x *IndexMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[23]
+	mi := &file_datalayer_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1969,7 +2304,7 @@ func (x *IndexMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexMetadata.ProtoReflect.Descriptor instead.
 func (*IndexMetadata) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{23}
+	return file_datalayer_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *IndexMetadata) GetName() string {
@@ -2011,7 +2346,7 @@ type DescribeTableResponse struct {
 
 func (x *DescribeTableResponse) Reset() {
 	*x = DescribeTableResponse{}
-	mi := &file_datalayer_proto_msgTypes[24]
+	mi := &file_datalayer_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2023,7 +2358,741 @@ func (x *DescribeTableResponse) String() string {
 func (*DescribeTableResponse) ProtoMessage() {}
 
 func (x *DescribeTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[24]
+	mi := &file_datalayer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTableResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTableResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DescribeTableResponse) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *DescribeTableResponse) GetColumns() []*ColumnMetadata {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DescribeTableResponse) GetIndices() []*IndexMetadata {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type ExecRawSQLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Db            string                 `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+	Sql           string                 `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Optional: Raw SQL runs on the primary by default. Set to EVENTUAL to send
+	// read-only statements (SELECT, SHOW, ...) to a replica.
+	Consistency   Consistency `protobuf:"varint,4,opt,name=consistency,proto3,enum=datalayer.v1.Consistency" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRawSQLRequest) Reset() {
+	*x = ExecRawSQLRequest{}
+	mi := &file_datalayer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRawSQLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRawSQLRequest) ProtoMessage() {}
+
+func (x *ExecRawSQLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRawSQLRequest.ProtoReflect.Descriptor instead.
+func (*ExecRawSQLRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExecRawSQLRequest) GetDb() string {
+	if x != nil {
+		return x.Db
+	}
+	return ""
+}
+
+func (x *ExecRawSQLRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *ExecRawSQLRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ExecRawSQLRequest) GetConsistency() Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return Consistency_CONSISTENCY_UNSPECIFIED
+}
+
+type ExecRawSQLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRawSQLResponse) Reset() {
+	*x = ExecRawSQLResponse{}
+	mi := &file_datalayer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRawSQLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRawSQLResponse) ProtoMessage() {}
+
+func (x *ExecRawSQLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRawSQLResponse.ProtoReflect.Descriptor instead.
+func (*ExecRawSQLResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExecRawSQLResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *ExecRawSQLResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// --- Cache Admin ---
+type InspectCacheRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CacheNamespace string                 `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"` // Required
+	Table          *TableSchema           `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`                                         // Required
+	// Required: cache key fields and their values, same as the equality conditions of a cached query.
+	Key           *Row `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectCacheRequest) Reset() {
+	*x = InspectCacheRequest{}
+	mi := &file_datalayer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectCacheRequest) ProtoMessage() {}
+
+func (x *InspectCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectCacheRequest.ProtoReflect.Descriptor instead.
+func (*InspectCacheRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InspectCacheRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *InspectCacheRequest) GetTable() *TableSchema {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *InspectCacheRequest) GetKey() *Row {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type InspectCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CacheKey      string                 `protobuf:"bytes,1,opt,name=cache_key,json=cacheKey,proto3" json:"cache_key,omitempty"` // The Redis key of the entry
+	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`         // Remaining TTL, -1 if the key has no expiration
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`            // Size of the stored payload
+	Value         *QueryResponse         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`                                      // Decoded cached response
+	DecodeError   string                 `protobuf:"bytes,6,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`       // Set if the stored payload could not be decoded
+	InLocalCache  bool                   `protobuf:"varint,7,opt,name=in_local_cache,json=inLocalCache,proto3" json:"in_local_cache,omitempty"` // Whether this instance holds the entry in its in-process cache
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectCacheResponse) Reset() {
+	*x = InspectCacheResponse{}
+	mi := &file_datalayer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectCacheResponse) ProtoMessage() {}
+
+func (x *InspectCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectCacheResponse.ProtoReflect.Descriptor instead.
+func (*InspectCacheResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InspectCacheResponse) GetCacheKey() string {
+	if x != nil {
+		return x.CacheKey
+	}
+	return ""
+}
+
+func (x *InspectCacheResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *InspectCacheResponse) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *InspectCacheResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *InspectCacheResponse) GetValue() *QueryResponse {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *InspectCacheResponse) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+func (x *InspectCacheResponse) GetInLocalCache() bool {
+	if x != nil {
+		return x.InLocalCache
+	}
+	return false
+}
+
+type EvictCacheRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CacheNamespace string                 `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"` // Required
+	Table          *TableSchema           `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`                                         // Required when keys is set
+	Keys           []*Row                 `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                                           // Cache key fields and their values
+	CacheKeys      []string               `protobuf:"bytes,4,rep,name=cache_keys,json=cacheKeys,proto3" json:"cache_keys,omitempty"`                // Raw Redis keys as returned by InspectCache
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvictCacheRequest) Reset() {
+	*x = EvictCacheRequest{}
+	mi := &file_datalayer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictCacheRequest) ProtoMessage() {}
+
+func (x *EvictCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictCacheRequest.ProtoReflect.Descriptor instead.
+func (*EvictCacheRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EvictCacheRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *EvictCacheRequest) GetTable() *TableSchema {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *EvictCacheRequest) GetKeys() []*Row {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *EvictCacheRequest) GetCacheKeys() []string {
+	if x != nil {
+		return x.CacheKeys
+	}
+	return nil
+}
+
+type EvictCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evicted       int64                  `protobuf:"varint,1,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictCacheResponse) Reset() {
+	*x = EvictCacheResponse{}
+	mi := &file_datalayer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictCacheResponse) ProtoMessage() {}
+
+func (x *EvictCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictCacheResponse.ProtoReflect.Descriptor instead.
+func (*EvictCacheResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EvictCacheResponse) GetEvicted() int64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+type FlushCacheRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CacheNamespace string                 `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"` // Required
+	// Optional: flush only this table's entries. If empty, all keys under the namespace key prefix are
+	// removed. A namespace without a key prefix can only be flushed as a whole when it has its own Redis DB.
+	Table         *TableSchema `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheRequest) Reset() {
+	*x = FlushCacheRequest{}
+	mi := &file_datalayer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheRequest) ProtoMessage() {}
+
+func (x *FlushCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FlushCacheRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *FlushCacheRequest) GetTable() *TableSchema {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+type FlushCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int64                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheResponse) Reset() {
+	*x = FlushCacheResponse{}
+	mi := &file_datalayer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheResponse) ProtoMessage() {}
+
+func (x *FlushCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FlushCacheResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type WarmCacheRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CacheNamespace  string                 `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`       // Required
+	Table           *TableSchema           `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`                                               // Required
+	Keys            []*Row                 `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                                                 // Cache key fields and their values to load
+	CacheTtlSeconds int64                  `protobuf:"varint,4,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"` // Optional: defaults to the namespace TTL
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WarmCacheRequest) Reset() {
+	*x = WarmCacheRequest{}
+	mi := &file_datalayer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheRequest) ProtoMessage() {}
+
+func (x *WarmCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheRequest.ProtoReflect.Descriptor instead.
+func (*WarmCacheRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WarmCacheRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *WarmCacheRequest) GetTable() *TableSchema {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *WarmCacheRequest) GetKeys() []*Row {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *WarmCacheRequest) GetCacheTtlSeconds() int64 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
+}
+
+type WarmCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warmed        int64                  `protobuf:"varint,1,opt,name=warmed,proto3" json:"warmed,omitempty"`
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // One message per key that failed to load
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheResponse) Reset() {
+	*x = WarmCacheResponse{}
+	mi := &file_datalayer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheResponse) ProtoMessage() {}
+
+func (x *WarmCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheResponse.ProtoReflect.Descriptor instead.
+func (*WarmCacheResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WarmCacheResponse) GetWarmed() int64 {
+	if x != nil {
+		return x.Warmed
+	}
+	return 0
+}
+
+func (x *WarmCacheResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetCacheStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filters, empty matches all.
+	CacheNamespace string `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	DbName         string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName      string `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsRequest) Reset() {
+	*x = GetCacheStatsRequest{}
+	mi := &file_datalayer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCacheStatsRequest) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *GetCacheStatsRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetCacheStatsRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+type CacheTableStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CacheNamespace    string                 `protobuf:"bytes,1,opt,name=cache_namespace,json=cacheNamespace,proto3" json:"cache_namespace,omitempty"`
+	DbName            string                 `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TableName         string                 `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Hits              int64                  `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`                                                    // Hits served from the in-process cache or Redis
+	LocalHits         int64                  `protobuf:"varint,5,opt,name=local_hits,json=localHits,proto3" json:"local_hits,omitempty"`                         // Hits served from the in-process cache
+	Misses            int64                  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`                                                // Lookups that fell through to the database
+	FillErrors        int64                  `protobuf:"varint,7,opt,name=fill_errors,json=fillErrors,proto3" json:"fill_errors,omitempty"`                      // Failures writing a database result back to the cache
+	UnmarshalFailures int64                  `protobuf:"varint,8,opt,name=unmarshal_failures,json=unmarshalFailures,proto3" json:"unmarshal_failures,omitempty"` // Cached payloads that could not be decoded
+	Invalidations     int64                  `protobuf:"varint,9,opt,name=invalidations,proto3" json:"invalidations,omitempty"`                                  // Cache keys removed by writes or cache administration
+	BytesServed       int64                  `protobuf:"varint,10,opt,name=bytes_served,json=bytesServed,proto3" json:"bytes_served,omitempty"`                  // Payload bytes returned from cache hits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CacheTableStats) Reset() {
+	*x = CacheTableStats{}
+	mi := &file_datalayer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheTableStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheTableStats) ProtoMessage() {}
+
+func (x *CacheTableStats) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2034,56 +3103,148 @@ func (x *DescribeTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTableResponse.ProtoReflect.Descriptor instead.
-func (*DescribeTableResponse) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CacheTableStats.ProtoReflect.Descriptor instead.
+func (*CacheTableStats) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DescribeTableResponse) GetTableName() string {
+func (x *CacheTableStats) GetCacheNamespace() string {
+	if x != nil {
+		return x.CacheNamespace
+	}
+	return ""
+}
+
+func (x *CacheTableStats) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *CacheTableStats) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-func (x *DescribeTableResponse) GetColumns() []*ColumnMetadata {
+func (x *CacheTableStats) GetHits() int64 {
 	if x != nil {
-		return x.Columns
+		return x.Hits
 	}
-	return nil
+	return 0
 }
 
-func (x *DescribeTableResponse) GetIndices() []*IndexMetadata {
+func (x *CacheTableStats) GetLocalHits() int64 {
 	if x != nil {
-		return x.Indices
+		return x.LocalHits
+	}
+	return 0
+}
+
+func (x *CacheTableStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheTableStats) GetFillErrors() int64 {
+	if x != nil {
+		return x.FillErrors
+	}
+	return 0
+}
+
+func (x *CacheTableStats) GetUnmarshalFailures() int64 {
+	if x != nil {
+		return x.UnmarshalFailures
+	}
+	return 0
+}
+
+func (x *CacheTableStats) GetInvalidations() int64 {
+	if x != nil {
+		return x.Invalidations
+	}
+	return 0
+}
+
+func (x *CacheTableStats) GetBytesServed() int64 {
+	if x != nil {
+		return x.BytesServed
+	}
+	return 0
+}
+
+type GetCacheStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*CacheTableStats     `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsResponse) Reset() {
+	*x = GetCacheStatsResponse{}
+	mi := &file_datalayer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCacheStatsResponse) GetStats() []*CacheTableStats {
+	if x != nil {
+		return x.Stats
 	}
 	return nil
 }
 
-type ExecRawSQLRequest struct {
+// --- Database Admin ---
+type GetPoolStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Db            string                 `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
-	Sql           string                 `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	DbName        string                 `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"` // Optional: empty returns all databases
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecRawSQLRequest) Reset() {
-	*x = ExecRawSQLRequest{}
-	mi := &file_datalayer_proto_msgTypes[25]
+func (x *GetPoolStatsRequest) Reset() {
+	*x = GetPoolStatsRequest{}
+	mi := &file_datalayer_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecRawSQLRequest) String() string {
+func (x *GetPoolStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRawSQLRequest) ProtoMessage() {}
+func (*GetPoolStatsRequest) ProtoMessage() {}
 
-func (x *ExecRawSQLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[25]
+func (x *GetPoolStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2094,55 +3255,205 @@ func (x *ExecRawSQLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRawSQLRequest.ProtoReflect.Descriptor instead.
-func (*ExecRawSQLRequest) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetPoolStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolStatsRequest) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ExecRawSQLRequest) GetDb() string {
+func (x *GetPoolStatsRequest) GetDbName() string {
 	if x != nil {
-		return x.Db
+		return x.DbName
 	}
 	return ""
 }
 
-func (x *ExecRawSQLRequest) GetSql() string {
+type PoolStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DbName             string                 `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Role               string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                      // "primary" or "replica"
+	ReplicaIndex       int32                  `protobuf:"varint,3,opt,name=replica_index,json=replicaIndex,proto3" json:"replica_index,omitempty"` // Index in replica_dsns, only for replicas
+	Healthy            bool                   `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy,omitempty"`                               // For the primary, false while its circuit breaker is open
+	MaxOpenConnections int32                  `protobuf:"varint,5,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections    int32                  `protobuf:"varint,6,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse              int32                  `protobuf:"varint,7,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle               int32                  `protobuf:"varint,8,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount          int64                  `protobuf:"varint,9,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationMs     int64                  `protobuf:"varint,10,opt,name=wait_duration_ms,json=waitDurationMs,proto3" json:"wait_duration_ms,omitempty"`
+	MaxIdleClosed      int64                  `protobuf:"varint,11,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxIdleTimeClosed  int64                  `protobuf:"varint,12,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	MaxLifetimeClosed  int64                  `protobuf:"varint,13,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	// Circuit breaker and concurrency limit, only for the primary
+	BreakerState          string `protobuf:"bytes,14,opt,name=breaker_state,json=breakerState,proto3" json:"breaker_state,omitempty"` // "closed", "open", "half_open" or "disabled"
+	InFlightRequests      int32  `protobuf:"varint,15,opt,name=in_flight_requests,json=inFlightRequests,proto3" json:"in_flight_requests,omitempty"`
+	MaxConcurrentRequests int32  `protobuf:"varint,16,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"` // 0 means unlimited
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PoolStats) Reset() {
+	*x = PoolStats{}
+	mi := &file_datalayer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStats) ProtoMessage() {}
+
+func (x *PoolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[42]
 	if x != nil {
-		return x.Sql
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolStats.ProtoReflect.Descriptor instead.
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PoolStats) GetDbName() string {
+	if x != nil {
+		return x.DbName
 	}
 	return ""
 }
 
-func (x *ExecRawSQLRequest) GetTransactionId() string {
+func (x *PoolStats) GetRole() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Role
 	}
 	return ""
 }
 
-type ExecRawSQLResponse struct {
+func (x *PoolStats) GetReplicaIndex() int32 {
+	if x != nil {
+		return x.ReplicaIndex
+	}
+	return 0
+}
+
+func (x *PoolStats) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *PoolStats) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *PoolStats) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *PoolStats) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *PoolStats) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *PoolStats) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *PoolStats) GetWaitDurationMs() int64 {
+	if x != nil {
+		return x.WaitDurationMs
+	}
+	return 0
+}
+
+func (x *PoolStats) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *PoolStats) GetMaxIdleTimeClosed() int64 {
+	if x != nil {
+		return x.MaxIdleTimeClosed
+	}
+	return 0
+}
+
+func (x *PoolStats) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+func (x *PoolStats) GetBreakerState() string {
+	if x != nil {
+		return x.BreakerState
+	}
+	return ""
+}
+
+func (x *PoolStats) GetInFlightRequests() int32 {
+	if x != nil {
+		return x.InFlightRequests
+	}
+	return 0
+}
+
+func (x *PoolStats) GetMaxConcurrentRequests() int32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+type GetPoolStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
-	Rows          []*Row                 `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Stats         []*PoolStats           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecRawSQLResponse) Reset() {
-	*x = ExecRawSQLResponse{}
-	mi := &file_datalayer_proto_msgTypes[26]
+func (x *GetPoolStatsResponse) Reset() {
+	*x = GetPoolStatsResponse{}
+	mi := &file_datalayer_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecRawSQLResponse) String() string {
+func (x *GetPoolStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRawSQLResponse) ProtoMessage() {}
+func (*GetPoolStatsResponse) ProtoMessage() {}
 
-func (x *ExecRawSQLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datalayer_proto_msgTypes[26]
+func (x *GetPoolStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datalayer_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,21 +3464,14 @@ func (x *ExecRawSQLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRawSQLResponse.ProtoReflect.Descriptor instead.
-func (*ExecRawSQLResponse) Descriptor() ([]byte, []int) {
-	return file_datalayer_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *ExecRawSQLResponse) GetAffectedRows() int64 {
-	if x != nil {
-		return x.AffectedRows
-	}
-	return 0
+// Deprecated: Use GetPoolStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return file_datalayer_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ExecRawSQLResponse) GetRows() []*Row {
+func (x *GetPoolStatsResponse) GetStats() []*PoolStats {
 	if x != nil {
-		return x.Rows
+		return x.Stats
 	}
 	return nil
 }
@@ -2222,7 +3526,7 @@ const file_datalayer_proto_rawDesc = "" +
 	"\vTableSchema\x12\x17\n" +
 	"\adb_name\x18\x01 \x01(\tR\x06dbName\x12\x1d\n" +
 	"\n" +
-	"table_name\x18\x02 \x01(\tR\ttableName\"\xb8\x05\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\"\xfa\x06\n" +
 	"\fQueryRequest\x12/\n" +
 	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12#\n" +
 	"\rselect_fields\x18\x02 \x03(\tR\fselectFields\x12=\n" +
@@ -2237,13 +3541,18 @@ const file_datalayer_proto_rawDesc = "" +
 	" \x01(\x03R\x06offset\x12%\n" +
 	"\x0etransaction_id\x18\v \x01(\tR\rtransactionId\x12.\n" +
 	"\x13request_total_count\x18\f \x01(\bR\x11requestTotalCount\x12$\n" +
-	"\x0ecache_by_field\x18\r \x01(\tR\fcacheByField\x12*\n" +
-	"\x11cache_ttl_seconds\x18\x0e \x01(\x03R\x0fcacheTtlSeconds\x120\n" +
-	"\bredis_db\x18\x0f \x01(\x0e2\x15.datalayer.v1.RedisDBR\aredisDb\"W\n" +
+	"\x0ecache_by_field\x18\r \x03(\tR\fcacheByField\x12*\n" +
+	"\x11cache_ttl_seconds\x18\x0e \x01(\x03R\x0fcacheTtlSeconds\x124\n" +
+	"\bredis_db\x18\x0f \x01(\x0e2\x15.datalayer.v1.RedisDBB\x02\x18\x01R\aredisDb\x12\x1f\n" +
+	"\vcache_empty\x18\x10 \x01(\bR\n" +
+	"cacheEmpty\x125\n" +
+	"\x17empty_cache_ttl_seconds\x18\x11 \x01(\x03R\x14emptyCacheTtlSeconds\x12'\n" +
+	"\x0fcache_namespace\x18\x12 \x01(\tR\x0ecacheNamespace\x12;\n" +
+	"\vconsistency\x18\x13 \x01(\x0e2\x19.datalayer.v1.ConsistencyR\vconsistency\"W\n" +
 	"\rQueryResponse\x12%\n" +
 	"\x04rows\x18\x01 \x03(\v2\x11.datalayer.v1.RowR\x04rows\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"\x9f\x02\n" +
+	"totalCount\"\xcd\x03\n" +
 	"\rInsertRequest\x12/\n" +
 	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12%\n" +
 	"\x04rows\x18\x02 \x03(\v2\x11.datalayer.v1.RowR\x04rows\x12=\n" +
@@ -2251,35 +3560,53 @@ const file_datalayer_proto_rawDesc = "" +
 	"onConflict\x12%\n" +
 	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12)\n" +
 	"\x10conflict_columns\x18\x05 \x03(\tR\x0fconflictColumns\x12%\n" +
-	"\x0eupdate_columns\x18\x06 \x03(\tR\rupdateColumns\"\xa4\x02\n" +
+	"\x0eupdate_columns\x18\x06 \x03(\tR\rupdateColumns\x12$\n" +
+	"\x0ecache_by_field\x18\a \x03(\tR\fcacheByField\x124\n" +
+	"\bredis_db\x18\b \x01(\x0e2\x15.datalayer.v1.RedisDBB\x02\x18\x01R\aredisDb\x12'\n" +
+	"\x0fcache_namespace\x18\t \x01(\tR\x0ecacheNamespace\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\"\xfa\x02\n" +
 	"\rUpdateRequest\x12/\n" +
 	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12%\n" +
 	"\x04data\x18\x02 \x01(\v2\x11.datalayer.v1.RowR\x04data\x12<\n" +
 	"\fwhere_clause\x18\x03 \x01(\v2\x19.datalayer.v1.WhereClauseR\vwhereClause\x12%\n" +
 	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12$\n" +
-	"\x0ecache_by_field\x18\x05 \x01(\tR\fcacheByField\x120\n" +
-	"\bredis_db\x18\x06 \x01(\x0e2\x15.datalayer.v1.RedisDBR\aredisDb\"\xfd\x01\n" +
+	"\x0ecache_by_field\x18\x05 \x03(\tR\fcacheByField\x124\n" +
+	"\bredis_db\x18\x06 \x01(\x0e2\x15.datalayer.v1.RedisDBB\x02\x18\x01R\aredisDb\x12'\n" +
+	"\x0fcache_namespace\x18\a \x01(\tR\x0ecacheNamespace\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKey\"\xd3\x02\n" +
 	"\rDeleteRequest\x12/\n" +
 	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12<\n" +
 	"\fwhere_clause\x18\x02 \x01(\v2\x19.datalayer.v1.WhereClauseR\vwhereClause\x12%\n" +
 	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12$\n" +
-	"\x0ecache_by_field\x18\x04 \x01(\tR\fcacheByField\x120\n" +
-	"\bredis_db\x18\x05 \x01(\x0e2\x15.datalayer.v1.RedisDBR\aredisDb\"7\n" +
+	"\x0ecache_by_field\x18\x04 \x03(\tR\fcacheByField\x124\n" +
+	"\bredis_db\x18\x05 \x01(\x0e2\x15.datalayer.v1.RedisDBB\x02\x18\x01R\aredisDb\x12'\n" +
+	"\x0fcache_namespace\x18\x06 \x01(\tR\x0ecacheNamespace\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\\\n" +
 	"\x10MutationResponse\x12#\n" +
-	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"2\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\x12#\n" +
+	"\rgenerated_ids\x18\x02 \x03(\x03R\fgeneratedIds\"2\n" +
 	"\x17BeginTransactionRequest\x12\x17\n" +
 	"\adb_name\x18\x01 \x01(\tR\x06dbName\"A\n" +
 	"\x18BeginTransactionResponse\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\";\n" +
 	"\x12TransactionRequest\x12%\n" +
-	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\",\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\x16\n" +
+	"\x14ListDatabasesRequest\"_\n" +
+	"\fDatabaseInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12#\n" +
+	"\rreplica_count\x18\x03 \x01(\x05R\freplicaCount\"Q\n" +
+	"\x15ListDatabasesResponse\x128\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x1a.datalayer.v1.DatabaseInfoR\tdatabases\",\n" +
 	"\x11ListTablesRequest\x12\x17\n" +
 	"\adb_name\x18\x01 \x01(\tR\x06dbName\"5\n" +
 	"\x12ListTablesResponse\x12\x1f\n" +
 	"\vtable_names\x18\x01 \x03(\tR\n" +
-	"tableNames\"G\n" +
+	"tableNames\"\x84\x01\n" +
 	"\x14DescribeTableRequest\x12/\n" +
-	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\"\xcc\x01\n" +
+	"\x05table\x18\x01 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12;\n" +
+	"\vconsistency\x18\x02 \x01(\x0e2\x19.datalayer.v1.ConsistencyR\vconsistency\"\xcc\x01\n" +
 	"\x0eColumnMetadata\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
 	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12\x1f\n" +
@@ -2299,14 +3626,95 @@ const file_datalayer_proto_rawDesc = "" +
 	"\n" +
 	"table_name\x18\x01 \x01(\tR\ttableName\x126\n" +
 	"\acolumns\x18\x02 \x03(\v2\x1c.datalayer.v1.ColumnMetadataR\acolumns\x125\n" +
-	"\aindices\x18\x03 \x03(\v2\x1b.datalayer.v1.IndexMetadataR\aindices\"\\\n" +
+	"\aindices\x18\x03 \x03(\v2\x1b.datalayer.v1.IndexMetadataR\aindices\"\x99\x01\n" +
 	"\x11ExecRawSQLRequest\x12\x0e\n" +
 	"\x02db\x18\x01 \x01(\tR\x02db\x12\x10\n" +
 	"\x03sql\x18\x02 \x01(\tR\x03sql\x12%\n" +
-	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"`\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12;\n" +
+	"\vconsistency\x18\x04 \x01(\x0e2\x19.datalayer.v1.ConsistencyR\vconsistency\"`\n" +
 	"\x12ExecRawSQLResponse\x12#\n" +
 	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\x12%\n" +
-	"\x04rows\x18\x02 \x03(\v2\x11.datalayer.v1.RowR\x04rows*B\n" +
+	"\x04rows\x18\x02 \x03(\v2\x11.datalayer.v1.RowR\x04rows\"\x94\x01\n" +
+	"\x13InspectCacheRequest\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12/\n" +
+	"\x05table\x18\x02 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12#\n" +
+	"\x03key\x18\x03 \x01(\v2\x11.datalayer.v1.RowR\x03key\"\x87\x02\n" +
+	"\x14InspectCacheResponse\x12\x1b\n" +
+	"\tcache_key\x18\x01 \x01(\tR\bcacheKey\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x121\n" +
+	"\x05value\x18\x05 \x01(\v2\x1b.datalayer.v1.QueryResponseR\x05value\x12!\n" +
+	"\fdecode_error\x18\x06 \x01(\tR\vdecodeError\x12$\n" +
+	"\x0ein_local_cache\x18\a \x01(\bR\finLocalCache\"\xb3\x01\n" +
+	"\x11EvictCacheRequest\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12/\n" +
+	"\x05table\x18\x02 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12%\n" +
+	"\x04keys\x18\x03 \x03(\v2\x11.datalayer.v1.RowR\x04keys\x12\x1d\n" +
+	"\n" +
+	"cache_keys\x18\x04 \x03(\tR\tcacheKeys\".\n" +
+	"\x12EvictCacheResponse\x12\x18\n" +
+	"\aevicted\x18\x01 \x01(\x03R\aevicted\"m\n" +
+	"\x11FlushCacheRequest\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12/\n" +
+	"\x05table\x18\x02 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\".\n" +
+	"\x12FlushCacheResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"\xbf\x01\n" +
+	"\x10WarmCacheRequest\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12/\n" +
+	"\x05table\x18\x02 \x01(\v2\x19.datalayer.v1.TableSchemaR\x05table\x12%\n" +
+	"\x04keys\x18\x03 \x03(\v2\x11.datalayer.v1.RowR\x04keys\x12*\n" +
+	"\x11cache_ttl_seconds\x18\x04 \x01(\x03R\x0fcacheTtlSeconds\"C\n" +
+	"\x11WarmCacheResponse\x12\x16\n" +
+	"\x06warmed\x18\x01 \x01(\x03R\x06warmed\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\"w\n" +
+	"\x14GetCacheStatsRequest\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12\x17\n" +
+	"\adb_name\x18\x02 \x01(\tR\x06dbName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x03 \x01(\tR\ttableName\"\xd6\x02\n" +
+	"\x0fCacheTableStats\x12'\n" +
+	"\x0fcache_namespace\x18\x01 \x01(\tR\x0ecacheNamespace\x12\x17\n" +
+	"\adb_name\x18\x02 \x01(\tR\x06dbName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x03 \x01(\tR\ttableName\x12\x12\n" +
+	"\x04hits\x18\x04 \x01(\x03R\x04hits\x12\x1d\n" +
+	"\n" +
+	"local_hits\x18\x05 \x01(\x03R\tlocalHits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x03R\x06misses\x12\x1f\n" +
+	"\vfill_errors\x18\a \x01(\x03R\n" +
+	"fillErrors\x12-\n" +
+	"\x12unmarshal_failures\x18\b \x01(\x03R\x11unmarshalFailures\x12$\n" +
+	"\rinvalidations\x18\t \x01(\x03R\rinvalidations\x12!\n" +
+	"\fbytes_served\x18\n" +
+	" \x01(\x03R\vbytesServed\"L\n" +
+	"\x15GetCacheStatsResponse\x123\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1d.datalayer.v1.CacheTableStatsR\x05stats\".\n" +
+	"\x13GetPoolStatsRequest\x12\x17\n" +
+	"\adb_name\x18\x01 \x01(\tR\x06dbName\"\xdc\x04\n" +
+	"\tPoolStats\x12\x17\n" +
+	"\adb_name\x18\x01 \x01(\tR\x06dbName\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12#\n" +
+	"\rreplica_index\x18\x03 \x01(\x05R\freplicaIndex\x12\x18\n" +
+	"\ahealthy\x18\x04 \x01(\bR\ahealthy\x120\n" +
+	"\x14max_open_connections\x18\x05 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x06 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\a \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\b \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\t \x01(\x03R\twaitCount\x12(\n" +
+	"\x10wait_duration_ms\x18\n" +
+	" \x01(\x03R\x0ewaitDurationMs\x12&\n" +
+	"\x0fmax_idle_closed\x18\v \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\f \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\r \x01(\x03R\x11maxLifetimeClosed\x12#\n" +
+	"\rbreaker_state\x18\x0e \x01(\tR\fbreakerState\x12,\n" +
+	"\x12in_flight_requests\x18\x0f \x01(\x05R\x10inFlightRequests\x126\n" +
+	"\x17max_concurrent_requests\x18\x10 \x01(\x05R\x15maxConcurrentRequests\"E\n" +
+	"\x14GetPoolStatsResponse\x12-\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.datalayer.v1.PoolStatsR\x05stats*B\n" +
 	"\rSortDirection\x12\x1e\n" +
 	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03ASC\x10\x01\x12\b\n" +
@@ -2358,7 +3766,12 @@ const file_datalayer_proto_rawDesc = "" +
 	"MANAGEMENT\x10\x04\x12\b\n" +
 	"\x04FILE\x10\x05\x12\x0e\n" +
 	"\n" +
-	"DEVICE_LOG\x10\x062\xa4\x04\n" +
+	"DEVICE_LOG\x10\x06*D\n" +
+	"\vConsistency\x12\x1b\n" +
+	"\x17CONSISTENCY_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bEVENTUAL\x10\x01\x12\n" +
+	"\n" +
+	"\x06STRONG\x10\x022\xa4\x04\n" +
 	"\bDataCRUD\x12@\n" +
 	"\x05Query\x12\x1a.datalayer.v1.QueryRequest\x1a\x1b.datalayer.v1.QueryResponse\x12E\n" +
 	"\x06Insert\x12\x1b.datalayer.v1.InsertRequest\x1a\x1e.datalayer.v1.MutationResponse\x12E\n" +
@@ -2366,14 +3779,26 @@ const file_datalayer_proto_rawDesc = "" +
 	"\x06Delete\x12\x1b.datalayer.v1.DeleteRequest\x1a\x1e.datalayer.v1.MutationResponse\x12a\n" +
 	"\x10BeginTransaction\x12%.datalayer.v1.BeginTransactionRequest\x1a&.datalayer.v1.BeginTransactionResponse\x12M\n" +
 	"\x11CommitTransaction\x12 .datalayer.v1.TransactionRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
-	"\x13RollbackTransaction\x12 .datalayer.v1.TransactionRequest\x1a\x16.google.protobuf.Empty2\xb5\x01\n" +
-	"\bMetadata\x12O\n" +
+	"\x13RollbackTransaction\x12 .datalayer.v1.TransactionRequest\x1a\x16.google.protobuf.Empty2\x8f\x02\n" +
+	"\bMetadata\x12X\n" +
+	"\rListDatabases\x12\".datalayer.v1.ListDatabasesRequest\x1a#.datalayer.v1.ListDatabasesResponse\x12O\n" +
 	"\n" +
 	"ListTables\x12\x1f.datalayer.v1.ListTablesRequest\x1a .datalayer.v1.ListTablesResponse\x12X\n" +
 	"\rDescribeTable\x12\".datalayer.v1.DescribeTableRequest\x1a#.datalayer.v1.DescribeTableResponse2Y\n" +
 	"\x06RawSql\x12O\n" +
 	"\n" +
-	"ExecRawSQL\x12\x1f.datalayer.v1.ExecRawSQLRequest\x1a .datalayer.v1.ExecRawSQLResponseB\x11Z\x0fdatalayer/v1;v1b\x06proto3"
+	"ExecRawSQL\x12\x1f.datalayer.v1.ExecRawSQLRequest\x1a .datalayer.v1.ExecRawSQLResponse2\xad\x03\n" +
+	"\n" +
+	"CacheAdmin\x12U\n" +
+	"\fInspectCache\x12!.datalayer.v1.InspectCacheRequest\x1a\".datalayer.v1.InspectCacheResponse\x12O\n" +
+	"\n" +
+	"EvictCache\x12\x1f.datalayer.v1.EvictCacheRequest\x1a .datalayer.v1.EvictCacheResponse\x12O\n" +
+	"\n" +
+	"FlushCache\x12\x1f.datalayer.v1.FlushCacheRequest\x1a .datalayer.v1.FlushCacheResponse\x12L\n" +
+	"\tWarmCache\x12\x1e.datalayer.v1.WarmCacheRequest\x1a\x1f.datalayer.v1.WarmCacheResponse\x12X\n" +
+	"\rGetCacheStats\x12\".datalayer.v1.GetCacheStatsRequest\x1a#.datalayer.v1.GetCacheStatsResponse2f\n" +
+	"\rDatabaseAdmin\x12U\n" +
+	"\fGetPoolStats\x12!.datalayer.v1.GetPoolStatsRequest\x1a\".datalayer.v1.GetPoolStatsResponseB\x11Z\x0fdatalayer/v1;v1b\x06proto3"
 
 var (
 	file_datalayer_proto_rawDescOnce sync.Once
@@ -2387,8 +3812,8 @@ func file_datalayer_proto_rawDescGZIP() []byte {
 	return file_datalayer_proto_rawDescData
 }
 
-var file_datalayer_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_datalayer_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_datalayer_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_datalayer_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_datalayer_proto_goTypes = []any{
 	(SortDirection)(0),               // 0: datalayer.v1.SortDirection
 	(Operator)(0),                    // 1: datalayer.v1.Operator
@@ -2396,101 +3821,148 @@ var file_datalayer_proto_goTypes = []any{
 	(ConflictAction)(0),              // 3: datalayer.v1.ConflictAction
 	(JoinType)(0),                    // 4: datalayer.v1.JoinType
 	(RedisDB)(0),                     // 5: datalayer.v1.RedisDB
-	(Aggregation_Function)(0),        // 6: datalayer.v1.Aggregation.Function
-	(*Row)(nil),                      // 7: datalayer.v1.Row
-	(*Condition)(nil),                // 8: datalayer.v1.Condition
-	(*WhereClause)(nil),              // 9: datalayer.v1.WhereClause
-	(*NestedClause)(nil),             // 10: datalayer.v1.NestedClause
-	(*OrderBy)(nil),                  // 11: datalayer.v1.OrderBy
-	(*FieldComparison)(nil),          // 12: datalayer.v1.FieldComparison
-	(*Join)(nil),                     // 13: datalayer.v1.Join
-	(*Aggregation)(nil),              // 14: datalayer.v1.Aggregation
-	(*GroupBy)(nil),                  // 15: datalayer.v1.GroupBy
-	(*TableSchema)(nil),              // 16: datalayer.v1.TableSchema
-	(*QueryRequest)(nil),             // 17: datalayer.v1.QueryRequest
-	(*QueryResponse)(nil),            // 18: datalayer.v1.QueryResponse
-	(*InsertRequest)(nil),            // 19: datalayer.v1.InsertRequest
-	(*UpdateRequest)(nil),            // 20: datalayer.v1.UpdateRequest
-	(*DeleteRequest)(nil),            // 21: datalayer.v1.DeleteRequest
-	(*MutationResponse)(nil),         // 22: datalayer.v1.MutationResponse
-	(*BeginTransactionRequest)(nil),  // 23: datalayer.v1.BeginTransactionRequest
-	(*BeginTransactionResponse)(nil), // 24: datalayer.v1.BeginTransactionResponse
-	(*TransactionRequest)(nil),       // 25: datalayer.v1.TransactionRequest
-	(*ListTablesRequest)(nil),        // 26: datalayer.v1.ListTablesRequest
-	(*ListTablesResponse)(nil),       // 27: datalayer.v1.ListTablesResponse
-	(*DescribeTableRequest)(nil),     // 28: datalayer.v1.DescribeTableRequest
-	(*ColumnMetadata)(nil),           // 29: datalayer.v1.ColumnMetadata
-	(*IndexMetadata)(nil),            // 30: datalayer.v1.IndexMetadata
-	(*DescribeTableResponse)(nil),    // 31: datalayer.v1.DescribeTableResponse
-	(*ExecRawSQLRequest)(nil),        // 32: datalayer.v1.ExecRawSQLRequest
-	(*ExecRawSQLResponse)(nil),       // 33: datalayer.v1.ExecRawSQLResponse
-	nil,                              // 34: datalayer.v1.Row.FieldsEntry
-	(*structpb.Value)(nil),           // 35: google.protobuf.Value
-	(*emptypb.Empty)(nil),            // 36: google.protobuf.Empty
+	(Consistency)(0),                 // 6: datalayer.v1.Consistency
+	(Aggregation_Function)(0),        // 7: datalayer.v1.Aggregation.Function
+	(*Row)(nil),                      // 8: datalayer.v1.Row
+	(*Condition)(nil),                // 9: datalayer.v1.Condition
+	(*WhereClause)(nil),              // 10: datalayer.v1.WhereClause
+	(*NestedClause)(nil),             // 11: datalayer.v1.NestedClause
+	(*OrderBy)(nil),                  // 12: datalayer.v1.OrderBy
+	(*FieldComparison)(nil),          // 13: datalayer.v1.FieldComparison
+	(*Join)(nil),                     // 14: datalayer.v1.Join
+	(*Aggregation)(nil),              // 15: datalayer.v1.Aggregation
+	(*GroupBy)(nil),                  // 16: datalayer.v1.GroupBy
+	(*TableSchema)(nil),              // 17: datalayer.v1.TableSchema
+	(*QueryRequest)(nil),             // 18: datalayer.v1.QueryRequest
+	(*QueryResponse)(nil),            // 19: datalayer.v1.QueryResponse
+	(*InsertRequest)(nil),            // 20: datalayer.v1.InsertRequest
+	(*UpdateRequest)(nil),            // 21: datalayer.v1.UpdateRequest
+	(*DeleteRequest)(nil),            // 22: datalayer.v1.DeleteRequest
+	(*MutationResponse)(nil),         // 23: datalayer.v1.MutationResponse
+	(*BeginTransactionRequest)(nil),  // 24: datalayer.v1.BeginTransactionRequest
+	(*BeginTransactionResponse)(nil), // 25: datalayer.v1.BeginTransactionResponse
+	(*TransactionRequest)(nil),       // 26: datalayer.v1.TransactionRequest
+	(*ListDatabasesRequest)(nil),     // 27: datalayer.v1.ListDatabasesRequest
+	(*DatabaseInfo)(nil),             // 28: datalayer.v1.DatabaseInfo
+	(*ListDatabasesResponse)(nil),    // 29: datalayer.v1.ListDatabasesResponse
+	(*ListTablesRequest)(nil),        // 30: datalayer.v1.ListTablesRequest
+	(*ListTablesResponse)(nil),       // 31: datalayer.v1.ListTablesResponse
+	(*DescribeTableRequest)(nil),     // 32: datalayer.v1.DescribeTableRequest
+	(*ColumnMetadata)(nil),           // 33: datalayer.v1.ColumnMetadata
+	(*IndexMetadata)(nil),            // 34: datalayer.v1.IndexMetadata
+	(*DescribeTableResponse)(nil),    // 35: datalayer.v1.DescribeTableResponse
+	(*ExecRawSQLRequest)(nil),        // 36: datalayer.v1.ExecRawSQLRequest
+	(*ExecRawSQLResponse)(nil),       // 37: datalayer.v1.ExecRawSQLResponse
+	(*InspectCacheRequest)(nil),      // 38: datalayer.v1.InspectCacheRequest
+	(*InspectCacheResponse)(nil),     // 39: datalayer.v1.InspectCacheResponse
+	(*EvictCacheRequest)(nil),        // 40: datalayer.v1.EvictCacheRequest
+	(*EvictCacheResponse)(nil),       // 41: datalayer.v1.EvictCacheResponse
+	(*FlushCacheRequest)(nil),        // 42: datalayer.v1.FlushCacheRequest
+	(*FlushCacheResponse)(nil),       // 43: datalayer.v1.FlushCacheResponse
+	(*WarmCacheRequest)(nil),         // 44: datalayer.v1.WarmCacheRequest
+	(*WarmCacheResponse)(nil),        // 45: datalayer.v1.WarmCacheResponse
+	(*GetCacheStatsRequest)(nil),     // 46: datalayer.v1.GetCacheStatsRequest
+	(*CacheTableStats)(nil),          // 47: datalayer.v1.CacheTableStats
+	(*GetCacheStatsResponse)(nil),    // 48: datalayer.v1.GetCacheStatsResponse
+	(*GetPoolStatsRequest)(nil),      // 49: datalayer.v1.GetPoolStatsRequest
+	(*PoolStats)(nil),                // 50: datalayer.v1.PoolStats
+	(*GetPoolStatsResponse)(nil),     // 51: datalayer.v1.GetPoolStatsResponse
+	nil,                              // 52: datalayer.v1.Row.FieldsEntry
+	(*structpb.Value)(nil),           // 53: google.protobuf.Value
+	(*emptypb.Empty)(nil),            // 54: google.protobuf.Empty
 }
 var file_datalayer_proto_depIdxs = []int32{
-	34, // 0: datalayer.v1.Row.fields:type_name -> datalayer.v1.Row.FieldsEntry
+	52, // 0: datalayer.v1.Row.fields:type_name -> datalayer.v1.Row.FieldsEntry
 	1,  // 1: datalayer.v1.Condition.operator:type_name -> datalayer.v1.Operator
-	35, // 2: datalayer.v1.Condition.literal_value:type_name -> google.protobuf.Value
-	17, // 3: datalayer.v1.Condition.subquery_value:type_name -> datalayer.v1.QueryRequest
-	8,  // 4: datalayer.v1.WhereClause.condition:type_name -> datalayer.v1.Condition
-	10, // 5: datalayer.v1.WhereClause.nested_clause:type_name -> datalayer.v1.NestedClause
+	53, // 2: datalayer.v1.Condition.literal_value:type_name -> google.protobuf.Value
+	18, // 3: datalayer.v1.Condition.subquery_value:type_name -> datalayer.v1.QueryRequest
+	9,  // 4: datalayer.v1.WhereClause.condition:type_name -> datalayer.v1.Condition
+	11, // 5: datalayer.v1.WhereClause.nested_clause:type_name -> datalayer.v1.NestedClause
 	2,  // 6: datalayer.v1.NestedClause.logical_operator:type_name -> datalayer.v1.LogicalOperator
-	9,  // 7: datalayer.v1.NestedClause.clauses:type_name -> datalayer.v1.WhereClause
+	10, // 7: datalayer.v1.NestedClause.clauses:type_name -> datalayer.v1.WhereClause
 	0,  // 8: datalayer.v1.OrderBy.direction:type_name -> datalayer.v1.SortDirection
 	1,  // 9: datalayer.v1.FieldComparison.operator:type_name -> datalayer.v1.Operator
 	4,  // 10: datalayer.v1.Join.type:type_name -> datalayer.v1.JoinType
-	12, // 11: datalayer.v1.Join.on_conditions:type_name -> datalayer.v1.FieldComparison
-	6,  // 12: datalayer.v1.Aggregation.function:type_name -> datalayer.v1.Aggregation.Function
-	16, // 13: datalayer.v1.QueryRequest.table:type_name -> datalayer.v1.TableSchema
-	14, // 14: datalayer.v1.QueryRequest.aggregations:type_name -> datalayer.v1.Aggregation
-	9,  // 15: datalayer.v1.QueryRequest.where_clause:type_name -> datalayer.v1.WhereClause
-	13, // 16: datalayer.v1.QueryRequest.joins:type_name -> datalayer.v1.Join
-	15, // 17: datalayer.v1.QueryRequest.group_by:type_name -> datalayer.v1.GroupBy
-	9,  // 18: datalayer.v1.QueryRequest.having_clause:type_name -> datalayer.v1.WhereClause
-	11, // 19: datalayer.v1.QueryRequest.order_by:type_name -> datalayer.v1.OrderBy
+	13, // 11: datalayer.v1.Join.on_conditions:type_name -> datalayer.v1.FieldComparison
+	7,  // 12: datalayer.v1.Aggregation.function:type_name -> datalayer.v1.Aggregation.Function
+	17, // 13: datalayer.v1.QueryRequest.table:type_name -> datalayer.v1.TableSchema
+	15, // 14: datalayer.v1.QueryRequest.aggregations:type_name -> datalayer.v1.Aggregation
+	10, // 15: datalayer.v1.QueryRequest.where_clause:type_name -> datalayer.v1.WhereClause
+	14, // 16: datalayer.v1.QueryRequest.joins:type_name -> datalayer.v1.Join
+	16, // 17: datalayer.v1.QueryRequest.group_by:type_name -> datalayer.v1.GroupBy
+	10, // 18: datalayer.v1.QueryRequest.having_clause:type_name -> datalayer.v1.WhereClause
+	12, // 19: datalayer.v1.QueryRequest.order_by:type_name -> datalayer.v1.OrderBy
 	5,  // 20: datalayer.v1.QueryRequest.redis_db:type_name -> datalayer.v1.RedisDB
-	7,  // 21: datalayer.v1.QueryResponse.rows:type_name -> datalayer.v1.Row
-	16, // 22: datalayer.v1.InsertRequest.table:type_name -> datalayer.v1.TableSchema
-	7,  // 23: datalayer.v1.InsertRequest.rows:type_name -> datalayer.v1.Row
-	3,  // 24: datalayer.v1.InsertRequest.on_conflict:type_name -> datalayer.v1.ConflictAction
-	16, // 25: datalayer.v1.UpdateRequest.table:type_name -> datalayer.v1.TableSchema
-	7,  // 26: datalayer.v1.UpdateRequest.data:type_name -> datalayer.v1.Row
-	9,  // 27: datalayer.v1.UpdateRequest.where_clause:type_name -> datalayer.v1.WhereClause
-	5,  // 28: datalayer.v1.UpdateRequest.redis_db:type_name -> datalayer.v1.RedisDB
-	16, // 29: datalayer.v1.DeleteRequest.table:type_name -> datalayer.v1.TableSchema
-	9,  // 30: datalayer.v1.DeleteRequest.where_clause:type_name -> datalayer.v1.WhereClause
-	5,  // 31: datalayer.v1.DeleteRequest.redis_db:type_name -> datalayer.v1.RedisDB
-	16, // 32: datalayer.v1.DescribeTableRequest.table:type_name -> datalayer.v1.TableSchema
-	29, // 33: datalayer.v1.DescribeTableResponse.columns:type_name -> datalayer.v1.ColumnMetadata
-	30, // 34: datalayer.v1.DescribeTableResponse.indices:type_name -> datalayer.v1.IndexMetadata
-	7,  // 35: datalayer.v1.ExecRawSQLResponse.rows:type_name -> datalayer.v1.Row
-	35, // 36: datalayer.v1.Row.FieldsEntry.value:type_name -> google.protobuf.Value
-	17, // 37: datalayer.v1.DataCRUD.Query:input_type -> datalayer.v1.QueryRequest
-	19, // 38: datalayer.v1.DataCRUD.Insert:input_type -> datalayer.v1.InsertRequest
-	20, // 39: datalayer.v1.DataCRUD.Update:input_type -> datalayer.v1.UpdateRequest
-	21, // 40: datalayer.v1.DataCRUD.Delete:input_type -> datalayer.v1.DeleteRequest
-	23, // 41: datalayer.v1.DataCRUD.BeginTransaction:input_type -> datalayer.v1.BeginTransactionRequest
-	25, // 42: datalayer.v1.DataCRUD.CommitTransaction:input_type -> datalayer.v1.TransactionRequest
-	25, // 43: datalayer.v1.DataCRUD.RollbackTransaction:input_type -> datalayer.v1.TransactionRequest
-	26, // 44: datalayer.v1.Metadata.ListTables:input_type -> datalayer.v1.ListTablesRequest
-	28, // 45: datalayer.v1.Metadata.DescribeTable:input_type -> datalayer.v1.DescribeTableRequest
-	32, // 46: datalayer.v1.RawSql.ExecRawSQL:input_type -> datalayer.v1.ExecRawSQLRequest
-	18, // 47: datalayer.v1.DataCRUD.Query:output_type -> datalayer.v1.QueryResponse
-	22, // 48: datalayer.v1.DataCRUD.Insert:output_type -> datalayer.v1.MutationResponse
-	22, // 49: datalayer.v1.DataCRUD.Update:output_type -> datalayer.v1.MutationResponse
-	22, // 50: datalayer.v1.DataCRUD.Delete:output_type -> datalayer.v1.MutationResponse
-	24, // 51: datalayer.v1.DataCRUD.BeginTransaction:output_type -> datalayer.v1.BeginTransactionResponse
-	36, // 52: datalayer.v1.DataCRUD.CommitTransaction:output_type -> google.protobuf.Empty
-	36, // 53: datalayer.v1.DataCRUD.RollbackTransaction:output_type -> google.protobuf.Empty
-	27, // 54: datalayer.v1.Metadata.ListTables:output_type -> datalayer.v1.ListTablesResponse
-	31, // 55: datalayer.v1.Metadata.DescribeTable:output_type -> datalayer.v1.DescribeTableResponse
-	33, // 56: datalayer.v1.RawSql.ExecRawSQL:output_type -> datalayer.v1.ExecRawSQLResponse
-	47, // [47:57] is the sub-list for method output_type
-	37, // [37:47] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	6,  // 21: datalayer.v1.QueryRequest.consistency:type_name -> datalayer.v1.Consistency
+	8,  // 22: datalayer.v1.QueryResponse.rows:type_name -> datalayer.v1.Row
+	17, // 23: datalayer.v1.InsertRequest.table:type_name -> datalayer.v1.TableSchema
+	8,  // 24: datalayer.v1.InsertRequest.rows:type_name -> datalayer.v1.Row
+	3,  // 25: datalayer.v1.InsertRequest.on_conflict:type_name -> datalayer.v1.ConflictAction
+	5,  // 26: datalayer.v1.InsertRequest.redis_db:type_name -> datalayer.v1.RedisDB
+	17, // 27: datalayer.v1.UpdateRequest.table:type_name -> datalayer.v1.TableSchema
+	8,  // 28: datalayer.v1.UpdateRequest.data:type_name -> datalayer.v1.Row
+	10, // 29: datalayer.v1.UpdateRequest.where_clause:type_name -> datalayer.v1.WhereClause
+	5,  // 30: datalayer.v1.UpdateRequest.redis_db:type_name -> datalayer.v1.RedisDB
+	17, // 31: datalayer.v1.DeleteRequest.table:type_name -> datalayer.v1.TableSchema
+	10, // 32: datalayer.v1.DeleteRequest.where_clause:type_name -> datalayer.v1.WhereClause
+	5,  // 33: datalayer.v1.DeleteRequest.redis_db:type_name -> datalayer.v1.RedisDB
+	28, // 34: datalayer.v1.ListDatabasesResponse.databases:type_name -> datalayer.v1.DatabaseInfo
+	17, // 35: datalayer.v1.DescribeTableRequest.table:type_name -> datalayer.v1.TableSchema
+	6,  // 36: datalayer.v1.DescribeTableRequest.consistency:type_name -> datalayer.v1.Consistency
+	33, // 37: datalayer.v1.DescribeTableResponse.columns:type_name -> datalayer.v1.ColumnMetadata
+	34, // 38: datalayer.v1.DescribeTableResponse.indices:type_name -> datalayer.v1.IndexMetadata
+	6,  // 39: datalayer.v1.ExecRawSQLRequest.consistency:type_name -> datalayer.v1.Consistency
+	8,  // 40: datalayer.v1.ExecRawSQLResponse.rows:type_name -> datalayer.v1.Row
+	17, // 41: datalayer.v1.InspectCacheRequest.table:type_name -> datalayer.v1.TableSchema
+	8,  // 42: datalayer.v1.InspectCacheRequest.key:type_name -> datalayer.v1.Row
+	19, // 43: datalayer.v1.InspectCacheResponse.value:type_name -> datalayer.v1.QueryResponse
+	17, // 44: datalayer.v1.EvictCacheRequest.table:type_name -> datalayer.v1.TableSchema
+	8,  // 45: datalayer.v1.EvictCacheRequest.keys:type_name -> datalayer.v1.Row
+	17, // 46: datalayer.v1.FlushCacheRequest.table:type_name -> datalayer.v1.TableSchema
+	17, // 47: datalayer.v1.WarmCacheRequest.table:type_name -> datalayer.v1.TableSchema
+	8,  // 48: datalayer.v1.WarmCacheRequest.keys:type_name -> datalayer.v1.Row
+	47, // 49: datalayer.v1.GetCacheStatsResponse.stats:type_name -> datalayer.v1.CacheTableStats
+	50, // 50: datalayer.v1.GetPoolStatsResponse.stats:type_name -> datalayer.v1.PoolStats
+	53, // 51: datalayer.v1.Row.FieldsEntry.value:type_name -> google.protobuf.Value
+	18, // 52: datalayer.v1.DataCRUD.Query:input_type -> datalayer.v1.QueryRequest
+	20, // 53: datalayer.v1.DataCRUD.Insert:input_type -> datalayer.v1.InsertRequest
+	21, // 54: datalayer.v1.DataCRUD.Update:input_type -> datalayer.v1.UpdateRequest
+	22, // 55: datalayer.v1.DataCRUD.Delete:input_type -> datalayer.v1.DeleteRequest
+	24, // 56: datalayer.v1.DataCRUD.BeginTransaction:input_type -> datalayer.v1.BeginTransactionRequest
+	26, // 57: datalayer.v1.DataCRUD.CommitTransaction:input_type -> datalayer.v1.TransactionRequest
+	26, // 58: datalayer.v1.DataCRUD.RollbackTransaction:input_type -> datalayer.v1.TransactionRequest
+	27, // 59: datalayer.v1.Metadata.ListDatabases:input_type -> datalayer.v1.ListDatabasesRequest
+	30, // 60: datalayer.v1.Metadata.ListTables:input_type -> datalayer.v1.ListTablesRequest
+	32, // 61: datalayer.v1.Metadata.DescribeTable:input_type -> datalayer.v1.DescribeTableRequest
+	36, // 62: datalayer.v1.RawSql.ExecRawSQL:input_type -> datalayer.v1.ExecRawSQLRequest
+	38, // 63: datalayer.v1.CacheAdmin.InspectCache:input_type -> datalayer.v1.InspectCacheRequest
+	40, // 64: datalayer.v1.CacheAdmin.EvictCache:input_type -> datalayer.v1.EvictCacheRequest
+	42, // 65: datalayer.v1.CacheAdmin.FlushCache:input_type -> datalayer.v1.FlushCacheRequest
+	44, // 66: datalayer.v1.CacheAdmin.WarmCache:input_type -> datalayer.v1.WarmCacheRequest
+	46, // 67: datalayer.v1.CacheAdmin.GetCacheStats:input_type -> datalayer.v1.GetCacheStatsRequest
+	49, // 68: datalayer.v1.DatabaseAdmin.GetPoolStats:input_type -> datalayer.v1.GetPoolStatsRequest
+	19, // 69: datalayer.v1.DataCRUD.Query:output_type -> datalayer.v1.QueryResponse
+	23, // 70: datalayer.v1.DataCRUD.Insert:output_type -> datalayer.v1.MutationResponse
+	23, // 71: datalayer.v1.DataCRUD.Update:output_type -> datalayer.v1.MutationResponse
+	23, // 72: datalayer.v1.DataCRUD.Delete:output_type -> datalayer.v1.MutationResponse
+	25, // 73: datalayer.v1.DataCRUD.BeginTransaction:output_type -> datalayer.v1.BeginTransactionResponse
+	54, // 74: datalayer.v1.DataCRUD.CommitTransaction:output_type -> google.protobuf.Empty
+	54, // 75: datalayer.v1.DataCRUD.RollbackTransaction:output_type -> google.protobuf.Empty
+	29, // 76: datalayer.v1.Metadata.ListDatabases:output_type -> datalayer.v1.ListDatabasesResponse
+	31, // 77: datalayer.v1.Metadata.ListTables:output_type -> datalayer.v1.ListTablesResponse
+	35, // 78: datalayer.v1.Metadata.DescribeTable:output_type -> datalayer.v1.DescribeTableResponse
+	37, // 79: datalayer.v1.RawSql.ExecRawSQL:output_type -> datalayer.v1.ExecRawSQLResponse
+	39, // 80: datalayer.v1.CacheAdmin.InspectCache:output_type -> datalayer.v1.InspectCacheResponse
+	41, // 81: datalayer.v1.CacheAdmin.EvictCache:output_type -> datalayer.v1.EvictCacheResponse
+	43, // 82: datalayer.v1.CacheAdmin.FlushCache:output_type -> datalayer.v1.FlushCacheResponse
+	45, // 83: datalayer.v1.CacheAdmin.WarmCache:output_type -> datalayer.v1.WarmCacheResponse
+	48, // 84: datalayer.v1.CacheAdmin.GetCacheStats:output_type -> datalayer.v1.GetCacheStatsResponse
+	51, // 85: datalayer.v1.DatabaseAdmin.GetPoolStats:output_type -> datalayer.v1.GetPoolStatsResponse
+	69, // [69:86] is the sub-list for method output_type
+	52, // [52:69] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_datalayer_proto_init() }
@@ -2511,10 +3983,10 @@ func file_datalayer_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_datalayer_proto_rawDesc), len(file_datalayer_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   28,
+			NumEnums:      8,
+			NumMessages:   45,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_datalayer_proto_goTypes,
 		DependencyIndexes: file_datalayer_proto_depIdxs,
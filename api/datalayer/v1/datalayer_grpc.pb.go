@@ -370,6 +370,7 @@ var DataCRUD_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
+	Metadata_ListDatabases_FullMethodName = "/datalayer.v1.Metadata/ListDatabases"
 	Metadata_ListTables_FullMethodName    = "/datalayer.v1.Metadata/ListTables"
 	Metadata_DescribeTable_FullMethodName = "/datalayer.v1.Metadata/DescribeTable"
 )
@@ -380,6 +381,8 @@ const (
 //
 // Metadata provides operations to inspect database schema.
 type MetadataClient interface {
+	// Lists configured databases.
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 	// Lists available tables.
 	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
 	// Describes the structure (columns, types, etc.) of a specific table.
@@ -394,6 +397,16 @@ func NewMetadataClient(cc grpc.ClientConnInterface) MetadataClient {
 	return &metadataClient{cc}
 }
 
+func (c *metadataClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, Metadata_ListDatabases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metadataClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTablesResponse)
@@ -420,6 +433,8 @@ func (c *metadataClient) DescribeTable(ctx context.Context, in *DescribeTableReq
 //
 // Metadata provides operations to inspect database schema.
 type MetadataServer interface {
+	// Lists configured databases.
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 	// Lists available tables.
 	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
 	// Describes the structure (columns, types, etc.) of a specific table.
@@ -434,6 +449,9 @@ type MetadataServer interface {
 // pointer dereference when methods are called.
 type UnimplementedMetadataServer struct{}
 
+func (UnimplementedMetadataServer) ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (UnimplementedMetadataServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
 }
@@ -461,6 +479,24 @@ func RegisterMetadataServer(s grpc.ServiceRegistrar, srv MetadataServer) {
 	s.RegisterService(&Metadata_ServiceDesc, srv)
 }
 
+func _Metadata_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Metadata_ListDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metadata_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTablesRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +540,10 @@ var Metadata_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "datalayer.v1.Metadata",
 	HandlerType: (*MetadataServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDatabases",
+			Handler:    _Metadata_ListDatabases_Handler,
+		},
 		{
 			MethodName: "ListTables",
 			Handler:    _Metadata_ListTables_Handler,
@@ -620,3 +660,379 @@ var RawSql_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "datalayer.proto",
 }
+
+const (
+	CacheAdmin_InspectCache_FullMethodName  = "/datalayer.v1.CacheAdmin/InspectCache"
+	CacheAdmin_EvictCache_FullMethodName    = "/datalayer.v1.CacheAdmin/EvictCache"
+	CacheAdmin_FlushCache_FullMethodName    = "/datalayer.v1.CacheAdmin/FlushCache"
+	CacheAdmin_WarmCache_FullMethodName     = "/datalayer.v1.CacheAdmin/WarmCache"
+	CacheAdmin_GetCacheStats_FullMethodName = "/datalayer.v1.CacheAdmin/GetCacheStats"
+)
+
+// CacheAdminClient is the client API for CacheAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CacheAdmin provides operations to inspect and manage the query cache.
+type CacheAdminClient interface {
+	// Inspects the cached entry for a table and its cache key values.
+	InspectCache(ctx context.Context, in *InspectCacheRequest, opts ...grpc.CallOption) (*InspectCacheResponse, error)
+	// Evicts single cache entries.
+	EvictCache(ctx context.Context, in *EvictCacheRequest, opts ...grpc.CallOption) (*EvictCacheResponse, error)
+	// Flushes all entries of a table, or of a whole namespace, without FLUSHDB.
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error)
+	// Reloads cache entries from the database for a list of keys.
+	WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error)
+	// Returns cache statistics per namespace, database and table since the server started.
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+}
+
+type cacheAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheAdminClient(cc grpc.ClientConnInterface) CacheAdminClient {
+	return &cacheAdminClient{cc}
+}
+
+func (c *cacheAdminClient) InspectCache(ctx context.Context, in *InspectCacheRequest, opts ...grpc.CallOption) (*InspectCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectCacheResponse)
+	err := c.cc.Invoke(ctx, CacheAdmin_InspectCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminClient) EvictCache(ctx context.Context, in *EvictCacheRequest, opts ...grpc.CallOption) (*EvictCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvictCacheResponse)
+	err := c.cc.Invoke(ctx, CacheAdmin_EvictCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushCacheResponse)
+	err := c.cc.Invoke(ctx, CacheAdmin_FlushCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminClient) WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarmCacheResponse)
+	err := c.cc.Invoke(ctx, CacheAdmin_WarmCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheAdminClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, CacheAdmin_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheAdminServer is the server API for CacheAdmin service.
+// All implementations must embed UnimplementedCacheAdminServer
+// for forward compatibility.
+//
+// CacheAdmin provides operations to inspect and manage the query cache.
+type CacheAdminServer interface {
+	// Inspects the cached entry for a table and its cache key values.
+	InspectCache(context.Context, *InspectCacheRequest) (*InspectCacheResponse, error)
+	// Evicts single cache entries.
+	EvictCache(context.Context, *EvictCacheRequest) (*EvictCacheResponse, error)
+	// Flushes all entries of a table, or of a whole namespace, without FLUSHDB.
+	FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error)
+	// Reloads cache entries from the database for a list of keys.
+	WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error)
+	// Returns cache statistics per namespace, database and table since the server started.
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	mustEmbedUnimplementedCacheAdminServer()
+}
+
+// UnimplementedCacheAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCacheAdminServer struct{}
+
+func (UnimplementedCacheAdminServer) InspectCache(context.Context, *InspectCacheRequest) (*InspectCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCache not implemented")
+}
+func (UnimplementedCacheAdminServer) EvictCache(context.Context, *EvictCacheRequest) (*EvictCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictCache not implemented")
+}
+func (UnimplementedCacheAdminServer) FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+func (UnimplementedCacheAdminServer) WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmCache not implemented")
+}
+func (UnimplementedCacheAdminServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedCacheAdminServer) mustEmbedUnimplementedCacheAdminServer() {}
+func (UnimplementedCacheAdminServer) testEmbeddedByValue()                    {}
+
+// UnsafeCacheAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheAdminServer will
+// result in compilation errors.
+type UnsafeCacheAdminServer interface {
+	mustEmbedUnimplementedCacheAdminServer()
+}
+
+func RegisterCacheAdminServer(s grpc.ServiceRegistrar, srv CacheAdminServer) {
+	// If the following call pancis, it indicates UnimplementedCacheAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CacheAdmin_ServiceDesc, srv)
+}
+
+func _CacheAdmin_InspectCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServer).InspectCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdmin_InspectCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServer).InspectCache(ctx, req.(*InspectCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdmin_EvictCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServer).EvictCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdmin_EvictCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServer).EvictCache(ctx, req.(*EvictCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdmin_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdmin_FlushCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdmin_WarmCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServer).WarmCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdmin_WarmCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServer).WarmCache(ctx, req.(*WarmCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheAdmin_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheAdminServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheAdmin_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheAdminServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CacheAdmin_ServiceDesc is the grpc.ServiceDesc for CacheAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CacheAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "datalayer.v1.CacheAdmin",
+	HandlerType: (*CacheAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InspectCache",
+			Handler:    _CacheAdmin_InspectCache_Handler,
+		},
+		{
+			MethodName: "EvictCache",
+			Handler:    _CacheAdmin_EvictCache_Handler,
+		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _CacheAdmin_FlushCache_Handler,
+		},
+		{
+			MethodName: "WarmCache",
+			Handler:    _CacheAdmin_WarmCache_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _CacheAdmin_GetCacheStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "datalayer.proto",
+}
+
+const (
+	DatabaseAdmin_GetPoolStats_FullMethodName = "/datalayer.v1.DatabaseAdmin/GetPoolStats"
+)
+
+// DatabaseAdminClient is the client API for DatabaseAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Administrative operations on the configured databases.
+type DatabaseAdminClient interface {
+	// Returns connection pool statistics of the primary and replicas of each database.
+	GetPoolStats(ctx context.Context, in *GetPoolStatsRequest, opts ...grpc.CallOption) (*GetPoolStatsResponse, error)
+}
+
+type databaseAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseAdminClient(cc grpc.ClientConnInterface) DatabaseAdminClient {
+	return &databaseAdminClient{cc}
+}
+
+func (c *databaseAdminClient) GetPoolStats(ctx context.Context, in *GetPoolStatsRequest, opts ...grpc.CallOption) (*GetPoolStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPoolStatsResponse)
+	err := c.cc.Invoke(ctx, DatabaseAdmin_GetPoolStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseAdminServer is the server API for DatabaseAdmin service.
+// All implementations must embed UnimplementedDatabaseAdminServer
+// for forward compatibility.
+//
+// Administrative operations on the configured databases.
+type DatabaseAdminServer interface {
+	// Returns connection pool statistics of the primary and replicas of each database.
+	GetPoolStats(context.Context, *GetPoolStatsRequest) (*GetPoolStatsResponse, error)
+	mustEmbedUnimplementedDatabaseAdminServer()
+}
+
+// UnimplementedDatabaseAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDatabaseAdminServer struct{}
+
+func (UnimplementedDatabaseAdminServer) GetPoolStats(context.Context, *GetPoolStatsRequest) (*GetPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolStats not implemented")
+}
+func (UnimplementedDatabaseAdminServer) mustEmbedUnimplementedDatabaseAdminServer() {}
+func (UnimplementedDatabaseAdminServer) testEmbeddedByValue()                       {}
+
+// UnsafeDatabaseAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseAdminServer will
+// result in compilation errors.
+type UnsafeDatabaseAdminServer interface {
+	mustEmbedUnimplementedDatabaseAdminServer()
+}
+
+func RegisterDatabaseAdminServer(s grpc.ServiceRegistrar, srv DatabaseAdminServer) {
+	// If the following call pancis, it indicates UnimplementedDatabaseAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DatabaseAdmin_ServiceDesc, srv)
+}
+
+func _DatabaseAdmin_GetPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseAdminServer).GetPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseAdmin_GetPoolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseAdminServer).GetPoolStats(ctx, req.(*GetPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DatabaseAdmin_ServiceDesc is the grpc.ServiceDesc for DatabaseAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DatabaseAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "datalayer.v1.DatabaseAdmin",
+	HandlerType: (*DatabaseAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPoolStats",
+			Handler:    _DatabaseAdmin_GetPoolStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "datalayer.proto",
+}
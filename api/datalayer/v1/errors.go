@@ -14,13 +14,28 @@ const (
 	ReasonUpdateFailed = "UPDATE_FAILED"
 	ReasonDeleteFailed = "DELETE_FAILED"
 
+	ReasonIdempotencyKeyReused        = "IDEMPOTENCY_KEY_REUSED"
+	ReasonIdempotencyInProgress       = "IDEMPOTENCY_IN_PROGRESS"
+	ReasonIdempotencyStoreUnavailable = "IDEMPOTENCY_STORE_UNAVAILABLE"
+	ReasonIdempotencyRecordCorrupted  = "IDEMPOTENCY_RECORD_CORRUPTED"
+	ReasonIdempotencyOutcomeUnknown   = "IDEMPOTENCY_OUTCOME_UNKNOWN"
+
 	ReasonTransactionError          = "TRANSACTION_ERROR"
 	ReasonTransactionCommitFailed   = "TRANSACTION_COMMIT_FAILED"
 	ReasonTransactionRollbackFailed = "TRANSACTION_ROLLBACK_FAILED"
 	ReasonInvalidTransactionID      = "INVALID_TRANSACTION_ID"
 
+	ReasonDatabaseNotFound = "DATABASE_NOT_FOUND"
+	ReasonUnavailable      = "UNAVAILABLE"
+
 	ReasonListTablesFailed     = "LIST_TABLES_FAILED"
 	ReasonDescribeTablesFailed = "DESCRIBE_TABLE_FAILED"
 
 	ReasonExecRawSqlFailed = "EXEC_Raw_SQL_FAILED"
+
+	ReasonCacheNamespaceNotFound = "CACHE_NAMESPACE_NOT_FOUND"
+	ReasonCacheAdminFailed       = "CACHE_ADMIN_FAILED"
+
+	ReasonUnauthorized  = "UNAUTHORIZED"
+	ReasonAdminDisabled = "ADMIN_DISABLED"
 )
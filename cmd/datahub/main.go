@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	zap "datahub/internal/log"
 	"flag"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -16,6 +18,9 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 
 	"datahub/internal/conf"
+	"datahub/internal/data"
+	"datahub/internal/server"
+	"datahub/internal/telemetry"
 
 	_ "go.uber.org/automaxprocs"
 )
@@ -35,12 +40,15 @@ var (
 	id, _ = os.Hostname()
 )
 
+// 退出时导出剩余 span 的最长时间
+const tracerShutdownTimeout = 5 * time.Second
+
 func init() {
 	flag.StringVar(&flagconf, "c", "../../configs/config.yaml", "config path, eg: -c config.yaml")
 	flag.BoolVar(&versionFlag, "v", false, "show version")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, as *server.AdminServer, bi *data.BinlogInvalidator, hc *data.HealthChecker, d *data.Data) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -49,7 +57,12 @@ func newApp(logger log.Logger, gs *grpc.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			gs,
+			as,
+			bi,
+			hc,
 		),
+		// 停止服务前等待未完成的事务，期间仍然处理事务内的请求
+		kratos.BeforeStop(d.DrainTransactions),
 	)
 }
 
@@ -75,6 +88,10 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	levels, err := zap.NewLevels(bc.Log.Level, bc.Log.Levels)
+	if err != nil {
+		panic(err)
+	}
 	logger := zap.NewLogger(&zap.Config{
 		Level:      bc.Log.Level,
 		Filename:   strings.TrimRight(bc.Log.Path, "/") + "/" + "datahub.log",
@@ -83,17 +100,48 @@ func main() {
 		MaxAge:     int(bc.Log.Expire),
 		Compress:   true,
 		Stdout:     bc.Log.Stdout,
-	})
+	}, levels)
 	log.SetLogger(logger)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Log, logger)
+	// 日志级别随配置文件变化，输出文件等其他配置仍需重启生效
+	if err := c.Watch("log", func(_ string, v config.Value) {
+		var lc conf.Log
+		if err := v.Scan(&lc); err != nil {
+			log.Errorf("scan log config error: %v", err)
+			return
+		}
+		if err := levels.Apply(lc.Level, lc.Levels); err != nil {
+			log.Errorf("apply log levels error: %v", err)
+			return
+		}
+		log.Infof("log level changed to %s, overrides: %v", lc.Level, lc.Levels)
+	}); err != nil {
+		panic(err)
+	}
+
+	// 在创建服务之前设置全局 TracerProvider
+	shutdownTracer, err := telemetry.InitTracer(bc.Trace, Name, Version, id)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracerShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracer(ctx); err != nil {
+			log.Errorf("shutdown tracer error: %v", err)
+		}
+	}()
+
+	app, cleanup, err := wireApp(c, bc.Server, bc.Data, bc.Log, levels, logger)
 	if err != nil {
 		panic(err)
 	}
 	defer cleanup()
 
+	done := make(chan struct{})
 	go func() {
-		if err = app.Run(); err != nil {
+		defer close(done)
+		if err := app.Run(); err != nil {
 			panic(err)
 		}
 	}()
@@ -104,7 +152,8 @@ func main() {
 
 	if err = app.Stop(); err != nil {
 		panic(err)
-	} else {
-		log.Infof("%s stopped", Name)
 	}
+	// 等待所有服务停止后再释放数据资源
+	<-done
+	log.Infof("%s stopped", Name)
 }
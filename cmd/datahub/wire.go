@@ -9,15 +9,17 @@ import (
 	"datahub/internal/biz"
 	"datahub/internal/conf"
 	"datahub/internal/data"
+	zaplog "datahub/internal/log"
 	"datahub/internal/server"
 	"datahub/internal/service"
 
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.Log, log.Logger) (*kratos.App, func(), error) {
+func wireApp(config.Config, *conf.Server, *conf.Data, *conf.Log, *zaplog.Levels, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -10,10 +10,12 @@ import (
 	"datahub/internal/biz"
 	"datahub/internal/conf"
 	"datahub/internal/data"
+	"datahub/internal/log"
 	"datahub/internal/server"
 	"datahub/internal/service"
 	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/config"
+	log2 "github.com/go-kratos/kratos/v2/log"
 )
 
 import (
@@ -23,26 +25,83 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, confLog *conf.Log, logger log.Logger) (*kratos.App, func(), error) {
-	v, err := data.NewDatabase(confData, confLog, logger)
+func wireApp(configConfig config.Config, confServer *conf.Server, confData *conf.Data, confLog *conf.Log, levels *log.Levels, logger log2.Logger) (*kratos.App, func(), error) {
+	slowQueryLog, cleanup, err := data.NewSlowQueryLog(confData, confLog, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	redisClient, err := data.NewRedisClients(confData, confLog, logger)
+	v, err := data.NewDatabase(confData, levels, logger, slowQueryLog)
 	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	v2, err := data.NewReplicas(confData, levels, logger, slowQueryLog)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	redisClient, err := data.NewRedisClients(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dataData, cleanup2, err := data.NewData(confData, configConfig, levels, logger, v, v2, redisClient, slowQueryLog)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	idempotencyStore, err := data.NewIdempotencyStore(confData, redisClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	datalayerRepo := data.NewDatalayerRepo(dataData, confData, idempotencyStore, logger)
+	localCache, cleanup3, err := data.NewLocalCache(confData, redisClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(confData, logger, v, redisClient)
+	cacheStats, err := data.NewCacheStats(dataData)
 	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	datalayerRepo := data.NewDatalayerRepo(dataData, logger)
-	bizDatalayerRepo := data.NewCachingDatalayerRepo(datalayerRepo, redisClient, logger)
-	datalayerUseCase := biz.NewDatalayerUseCase(bizDatalayerRepo, logger)
+	cacheCodec, cleanup4, err := data.NewCacheCodec(confData)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cachingDatalayerRepo := data.NewCachingDatalayerRepo(datalayerRepo, redisClient, localCache, cacheStats, cacheCodec, logger)
+	datalayerUseCase := biz.NewDatalayerUseCase(cachingDatalayerRepo, logger)
 	datalayerService := service.NewDatalayerService(datalayerUseCase)
-	grpcServer := server.NewGRPCServer(confServer, datalayerService, logger)
-	app := newApp(logger, grpcServer)
+	cacheAdminRepo := data.NewCacheAdminRepo(cachingDatalayerRepo, logger)
+	cacheAdminUseCase := biz.NewCacheAdminUseCase(cacheAdminRepo, logger)
+	cacheAdminService := service.NewCacheAdminService(cacheAdminUseCase)
+	databaseAdminRepo := data.NewDatabaseAdminRepo(dataData, logger)
+	databaseAdminUseCase := biz.NewDatabaseAdminUseCase(databaseAdminRepo, logger)
+	databaseAdminService := service.NewDatabaseAdminService(databaseAdminUseCase)
+	healthChecker := data.NewHealthChecker(confData, dataData, redisClient, logger)
+	grpcServer := server.NewGRPCServer(confServer, datalayerService, cacheAdminService, databaseAdminService, healthChecker, dataData, logger)
+	adminServer := server.NewAdminServer(confServer, levels, logger)
+	binlogInvalidator, err := data.NewBinlogInvalidator(confData, redisClient, cachingDatalayerRepo, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app := newApp(logger, grpcServer, adminServer, binlogInvalidator, healthChecker, dataData)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
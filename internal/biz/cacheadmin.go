@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"context"
+	"datahub/api/datalayer/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type CacheAdminRepo interface {
+	InspectCache(ctx context.Context, req *v1.InspectCacheRequest) (*v1.InspectCacheResponse, error)
+	EvictCache(ctx context.Context, req *v1.EvictCacheRequest) (*v1.EvictCacheResponse, error)
+	FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error)
+	WarmCache(ctx context.Context, req *v1.WarmCacheRequest) (*v1.WarmCacheResponse, error)
+	GetCacheStats(ctx context.Context, req *v1.GetCacheStatsRequest) (*v1.GetCacheStatsResponse, error)
+}
+
+type CacheAdminUseCase struct {
+	repo CacheAdminRepo
+	log  *log.Helper
+}
+
+func NewCacheAdminUseCase(repo CacheAdminRepo, logger log.Logger) *CacheAdminUseCase {
+	return &CacheAdminUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *CacheAdminUseCase) InspectCache(ctx context.Context, req *v1.InspectCacheRequest) (*v1.InspectCacheResponse, error) {
+	return uc.repo.InspectCache(ctx, req)
+}
+
+func (uc *CacheAdminUseCase) EvictCache(ctx context.Context, req *v1.EvictCacheRequest) (*v1.EvictCacheResponse, error) {
+	return uc.repo.EvictCache(ctx, req)
+}
+
+func (uc *CacheAdminUseCase) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	return uc.repo.FlushCache(ctx, req)
+}
+
+func (uc *CacheAdminUseCase) WarmCache(ctx context.Context, req *v1.WarmCacheRequest) (*v1.WarmCacheResponse, error) {
+	return uc.repo.WarmCache(ctx, req)
+}
+
+func (uc *CacheAdminUseCase) GetCacheStats(ctx context.Context, req *v1.GetCacheStatsRequest) (*v1.GetCacheStatsResponse, error) {
+	return uc.repo.GetCacheStats(ctx, req)
+}
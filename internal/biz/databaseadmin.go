@@ -0,0 +1,31 @@
+package biz
+
+import (
+	"context"
+	"datahub/api/datalayer/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type DatabaseAdminRepo interface {
+	GetPoolStats(ctx context.Context, req *v1.GetPoolStatsRequest) (*v1.GetPoolStatsResponse, error)
+}
+
+// DatabaseRegistry 判断数据库是否已配置、表是否存在，热更新后立即生效
+type DatabaseRegistry interface {
+	HasDatabase(name string) bool
+	HasTable(db, table string) bool
+}
+
+type DatabaseAdminUseCase struct {
+	repo DatabaseAdminRepo
+	log  *log.Helper
+}
+
+func NewDatabaseAdminUseCase(repo DatabaseAdminRepo, logger log.Logger) *DatabaseAdminUseCase {
+	return &DatabaseAdminUseCase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *DatabaseAdminUseCase) GetPoolStats(ctx context.Context, req *v1.GetPoolStatsRequest) (*v1.GetPoolStatsResponse, error) {
+	return uc.repo.GetPoolStats(ctx, req)
+}
@@ -16,6 +16,7 @@ type DatalayerRepo interface {
 	BeginTransaction(ctx context.Context, req *v1.BeginTransactionRequest) (*v1.BeginTransactionResponse, error)
 	CommitTransaction(ctx context.Context, req *v1.TransactionRequest) (*emptypb.Empty, error)
 	RollbackTransaction(ctx context.Context, req *v1.TransactionRequest) (*emptypb.Empty, error)
+	ListDatabases(ctx context.Context, req *v1.ListDatabasesRequest) (*v1.ListDatabasesResponse, error)
 	ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ListTablesResponse, error)
 	DescribeTable(ctx context.Context, req *v1.DescribeTableRequest) (*v1.DescribeTableResponse, error)
 	ExecRawSQL(ctx context.Context, req *v1.ExecRawSQLRequest) (*v1.ExecRawSQLResponse, error)
@@ -58,6 +59,10 @@ func (uc *DatalayerUseCase) RollbackTransaction(ctx context.Context, req *v1.Tra
 	return uc.repo.RollbackTransaction(ctx, req)
 }
 
+func (uc *DatalayerUseCase) ListDatabases(ctx context.Context, req *v1.ListDatabasesRequest) (*v1.ListDatabasesResponse, error) {
+	return uc.repo.ListDatabases(ctx, req)
+}
+
 func (uc *DatalayerUseCase) ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ListTablesResponse, error) {
 	return uc.repo.ListTables(ctx, req)
 }
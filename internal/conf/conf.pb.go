@@ -27,6 +27,7 @@ type Bootstrap struct {
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Log           *Log                   `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	Trace         *Trace                 `protobuf:"bytes,4,opt,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,21 +83,113 @@ func (x *Bootstrap) GetLog() *Log {
 	return nil
 }
 
+func (x *Bootstrap) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// 链路追踪，exporter 为空时不导出
+type Trace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "otlp_grpc"、"otlp_http"、"file" 或 "stdout"
+	Exporter string `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter,omitempty"`
+	// otlp 的地址，如 "127.0.0.1:4317"
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// otlp 不使用 TLS
+	Insecure bool `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// exporter 为 "file" 时写入的文件
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// 采样比例，0 表示全部采样；请求已带有采样决定时沿用上游的决定
+	SampleRatio   float64 `protobuf:"fixed64,5,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Trace) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *Trace) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Trace) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Trace) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Trace) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
 type Log struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Expire        int32                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
-	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Stdout        bool                   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Level  string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Path   string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size   int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Expire int32                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	Limit  int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Stdout bool                   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// 按 logger 名称覆盖 level，修改配置或调用管理端 /log/level 接口后立即生效。
+	// 名称是打印日志的包名（如 data、server）或 sql/<数据库名>，"sql" 对所有数据库的 SQL 日志生效
+	Levels        map[string]string `protobuf:"bytes,7,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Log) Reset() {
 	*x = Log{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -108,7 +201,7 @@ func (x *Log) String() string {
 func (*Log) ProtoMessage() {}
 
 func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -121,7 +214,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Log) GetLevel() string {
@@ -166,16 +259,24 @@ func (x *Log) GetStdout() bool {
 	return false
 }
 
+func (x *Log) GetLevels() map[string]string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,1,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Admin         *Server_Admin          `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
 	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +288,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +301,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Server) GetGrpc() *Server_GRPC {
@@ -210,17 +311,35 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetAdmin() *Server_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Databases     []*Data_Database       `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Databases    []*Data_Database       `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	Redis        *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	LocalCache   *Data_LocalCache       `protobuf:"bytes,3,opt,name=local_cache,json=localCache,proto3" json:"local_cache,omitempty"`
+	CachePayload *Data_CachePayload     `protobuf:"bytes,4,opt,name=cache_payload,json=cachePayload,proto3" json:"cache_payload,omitempty"`
+	Binlog       *Data_Binlog           `protobuf:"bytes,5,opt,name=binlog,proto3" json:"binlog,omitempty"`
+	// 热更新移除数据库时，等待旧连接上的事务结束的最长时间，默认 5m
+	DrainTimeout *durationpb.Duration `protobuf:"bytes,6,opt,name=drain_timeout,json=drainTimeout,proto3" json:"drain_timeout,omitempty"`
+	Retry        *Data_Retry          `protobuf:"bytes,7,opt,name=retry,proto3" json:"retry,omitempty"`
+	Idempotency  *Data_Idempotency    `protobuf:"bytes,8,opt,name=idempotency,proto3" json:"idempotency,omitempty"`
+	Health       *Data_Health         `protobuf:"bytes,9,opt,name=health,proto3" json:"health,omitempty"`
+	// 服务停止时等待未完成的事务提交的最长时间，超时后回滚，默认 30s
+	TransactionGracePeriod *durationpb.Duration `protobuf:"bytes,10,opt,name=transaction_grace_period,json=transactionGracePeriod,proto3" json:"transaction_grace_period,omitempty"`
+	SlowQuery              *Data_SlowQuery      `protobuf:"bytes,11,opt,name=slow_query,json=slowQuery,proto3" json:"slow_query,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +351,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +364,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Data) GetDatabases() []*Data_Database {
@@ -262,6 +381,69 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetLocalCache() *Data_LocalCache {
+	if x != nil {
+		return x.LocalCache
+	}
+	return nil
+}
+
+func (x *Data) GetCachePayload() *Data_CachePayload {
+	if x != nil {
+		return x.CachePayload
+	}
+	return nil
+}
+
+func (x *Data) GetBinlog() *Data_Binlog {
+	if x != nil {
+		return x.Binlog
+	}
+	return nil
+}
+
+func (x *Data) GetDrainTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DrainTimeout
+	}
+	return nil
+}
+
+func (x *Data) GetRetry() *Data_Retry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Data) GetIdempotency() *Data_Idempotency {
+	if x != nil {
+		return x.Idempotency
+	}
+	return nil
+}
+
+func (x *Data) GetHealth() *Data_Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Data) GetTransactionGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.TransactionGracePeriod
+	}
+	return nil
+}
+
+func (x *Data) GetSlowQuery() *Data_SlowQuery {
+	if x != nil {
+		return x.SlowQuery
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -272,7 +454,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +466,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +479,7 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
 func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *Server_GRPC) GetAddr() string {
@@ -314,17 +496,92 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
-type Data_Database struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Dsn           string                 `protobuf:"bytes,2,opt,name=dsn,proto3" json:"dsn,omitempty"`
+// 管理端 HTTP 服务，提供 /metrics 等接口
+type Server_Admin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Addr  string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 修改日志级别和 CacheAdmin、DatabaseAdmin 等 gRPC 管理接口需要在 Authorization 头中
+	// 携带 "Bearer <token>"，为空时禁止这些操作
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Server_Admin) Reset() {
+	*x = Server_Admin{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Admin) ProtoMessage() {}
+
+func (x *Server_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Admin.ProtoReflect.Descriptor instead.
+func (*Server_Admin) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Server_Admin) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_Admin) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Data_Database struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dsn   string                 `protobuf:"bytes,2,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// mysql（默认）、postgres 或 sqlite；sqlite 的 dsn 为文件路径或 :memory:
+	Driver string `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 只读副本，非事务的读请求优先发往副本
+	ReplicaDsns []string `protobuf:"bytes,4,rep,name=replica_dsns,json=replicaDsns,proto3" json:"replica_dsns,omitempty"`
+	// 副本健康检查间隔，默认 5s
+	ReplicaHealthInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=replica_health_interval,json=replicaHealthInterval,proto3" json:"replica_health_interval,omitempty"`
+	// 连接池，主库和每个副本分别生效；0 表示使用默认值
+	MaxOpenConns    int32                `protobuf:"varint,6,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	MaxIdleConns    int32                `protobuf:"varint,7,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,8,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,9,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// dsn 中未指定时使用的超时，read/write 只对 mysql 生效
+	ConnectTimeout *durationpb.Duration `protobuf:"bytes,10,opt,name=connect_timeout,json=connectTimeout,proto3" json:"connect_timeout,omitempty"`
+	ReadTimeout    *durationpb.Duration `protobuf:"bytes,11,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout   *durationpb.Duration `protobuf:"bytes,12,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	// 同时执行的请求数上限，0 表示不限制，避免一个慢库占满所有工作协程
+	MaxConcurrentRequests int32 `protobuf:"varint,13,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// 达到上限时等待空闲名额的最长时间，0 表示直接失败
+	MaxQueueWait   *durationpb.Duration          `protobuf:"bytes,14,opt,name=max_queue_wait,json=maxQueueWait,proto3" json:"max_queue_wait,omitempty"`
+	CircuitBreaker *Data_Database_CircuitBreaker `protobuf:"bytes,15,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +593,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +606,7 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
 func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *Data_Database) GetName() string {
@@ -366,18 +623,130 @@ func (x *Data_Database) GetDsn() string {
 	return ""
 }
 
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetReplicaDsns() []string {
+	if x != nil {
+		return x.ReplicaDsns
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReplicaHealthInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicaHealthInterval
+	}
+	return nil
+}
+
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxIdleConns() int32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetConnMaxIdleTime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxIdleTime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetConnectTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database) GetMaxConcurrentRequests() int32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxQueueWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxQueueWait
+	}
+	return nil
+}
+
+func (x *Data_Database) GetCircuitBreaker() *Data_Database_CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
 type Data_Redis struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Master        string                 `protobuf:"bytes,1,opt,name=master,proto3" json:"master,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	SentinelAddrs []string               `protobuf:"bytes,3,rep,name=sentinelAddrs,proto3" json:"sentinelAddrs,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sentinel 模式的主节点名称
+	Master        string   `protobuf:"bytes,1,opt,name=master,proto3" json:"master,omitempty"`
+	Password      string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	SentinelAddrs []string `protobuf:"bytes,3,rep,name=sentinelAddrs,proto3" json:"sentinelAddrs,omitempty"`
+	// 未配置时按 RedisDB 枚举生成，名称为枚举名的小写形式
+	Namespaces []*Data_Redis_Namespace `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// 部署模式：standalone、sentinel、cluster，未配置时有 master 则为 sentinel，否则为 standalone
+	Mode string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	// standalone 模式使用第一个地址，cluster 模式为种子节点
+	Addrs []string `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// ACL 用户名
+	Username         string               `protobuf:"bytes,7,opt,name=username,proto3" json:"username,omitempty"`
+	SentinelUsername string               `protobuf:"bytes,8,opt,name=sentinel_username,json=sentinelUsername,proto3" json:"sentinel_username,omitempty"`
+	SentinelPassword string               `protobuf:"bytes,9,opt,name=sentinel_password,json=sentinelPassword,proto3" json:"sentinel_password,omitempty"`
+	Tls              *Data_Redis_TLS      `protobuf:"bytes,10,opt,name=tls,proto3" json:"tls,omitempty"`
+	PoolSize         int32                `protobuf:"varint,11,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	MinIdleConns     int32                `protobuf:"varint,12,opt,name=min_idle_conns,json=minIdleConns,proto3" json:"min_idle_conns,omitempty"`
+	DialTimeout      *durationpb.Duration `protobuf:"bytes,13,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
+	ReadTimeout      *durationpb.Duration `protobuf:"bytes,14,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout     *durationpb.Duration `protobuf:"bytes,15,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	PoolTimeout      *durationpb.Duration `protobuf:"bytes,16,opt,name=pool_timeout,json=poolTimeout,proto3" json:"pool_timeout,omitempty"`
+	// 存放 binlog 位点、幂等记录等非缓存数据的 db，默认 15，cluster 模式默认且只能为 0。
+	// 不能与没有 key_prefix 的命名空间共用，否则清空命名空间时会一起删除
+	StateDb       *int32 `protobuf:"varint,17,opt,name=state_db,json=stateDb,proto3,oneof" json:"state_db,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +758,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +771,7 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *Data_Redis) GetMaster() string {
@@ -426,38 +795,1096 @@ func (x *Data_Redis) GetSentinelAddrs() []string {
 	return nil
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+func (x *Data_Redis) GetNamespaces() []*Data_Redis_Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
 
-const file_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x80\x01\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+func (x *Data_Redis) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetSentinelUsername() string {
+	if x != nil {
+		return x.SentinelUsername
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetSentinelPassword() string {
+	if x != nil {
+		return x.SentinelPassword
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetTls() *Data_Redis_TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *Data_Redis) GetMinIdleConns() int32 {
+	if x != nil {
+		return x.MinIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Redis) GetDialTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DialTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetPoolTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PoolTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetStateDb() int32 {
+	if x != nil && x.StateDb != nil {
+		return *x.StateDb
+	}
+	return 0
+}
+
+// 进程内一级缓存，位于 redis 之前
+type Data_LocalCache struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 最大缓存条目数
+	MaxEntries int32 `protobuf:"varint,2,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// 最大缓存字节数
+	MaxBytes int64                `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	Ttl      *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// 多副本之间广播失效消息的 redis pub/sub 频道
+	InvalidateChannel string `protobuf:"bytes,5,opt,name=invalidate_channel,json=invalidateChannel,proto3" json:"invalidate_channel,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Data_LocalCache) Reset() {
+	*x = Data_LocalCache{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_LocalCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_LocalCache) ProtoMessage() {}
+
+func (x *Data_LocalCache) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_LocalCache.ProtoReflect.Descriptor instead.
+func (*Data_LocalCache) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *Data_LocalCache) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_LocalCache) GetMaxEntries() int32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *Data_LocalCache) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Data_LocalCache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_LocalCache) GetInvalidateChannel() string {
+	if x != nil {
+		return x.InvalidateChannel
+	}
+	return ""
+}
+
+// 缓存内容的编码方式
+type Data_CachePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 压缩算法：none、snappy、zstd
+	Compression string `protobuf:"bytes,1,opt,name=compression,proto3" json:"compression,omitempty"`
+	// 超过该字节数才压缩
+	CompressThreshold int32 `protobuf:"varint,2,opt,name=compress_threshold,json=compressThreshold,proto3" json:"compress_threshold,omitempty"`
+	// 表结构变更后修改此值，旧的缓存条目会被当作未命中
+	SchemaVersion string `protobuf:"bytes,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_CachePayload) Reset() {
+	*x = Data_CachePayload{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_CachePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_CachePayload) ProtoMessage() {}
+
+func (x *Data_CachePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_CachePayload.ProtoReflect.Descriptor instead.
+func (*Data_CachePayload) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *Data_CachePayload) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *Data_CachePayload) GetCompressThreshold() int32 {
+	if x != nil {
+		return x.CompressThreshold
+	}
+	return 0
+}
+
+func (x *Data_CachePayload) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// 订阅 mysql binlog，清除绕过 datahub 直接写库导致的过期缓存
+type Data_Binlog struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Enabled  bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Addr     string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	User     string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Password string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	ServerId uint32                 `protobuf:"varint,5,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// mysql 或 mariadb
+	Flavor string `protobuf:"bytes,6,opt,name=flavor,proto3" json:"flavor,omitempty"`
+	// 保存 binlog 位点的 redis key
+	CheckpointKey      string               `protobuf:"bytes,7,opt,name=checkpoint_key,json=checkpointKey,proto3" json:"checkpoint_key,omitempty"`
+	CheckpointInterval *durationpb.Duration `protobuf:"bytes,8,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"`
+	Tables             []*Data_Binlog_Table `protobuf:"bytes,9,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Data_Binlog) Reset() {
+	*x = Data_Binlog{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Binlog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Binlog) ProtoMessage() {}
+
+func (x *Data_Binlog) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Binlog.ProtoReflect.Descriptor instead.
+func (*Data_Binlog) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *Data_Binlog) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_Binlog) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Binlog) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Data_Binlog) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Binlog) GetServerId() uint32 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *Data_Binlog) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+func (x *Data_Binlog) GetCheckpointKey() string {
+	if x != nil {
+		return x.CheckpointKey
+	}
+	return ""
+}
+
+func (x *Data_Binlog) GetCheckpointInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CheckpointInterval
+	}
+	return nil
+}
+
+func (x *Data_Binlog) GetTables() []*Data_Binlog_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// 连接类错误的重试预算，用于只读操作和携带幂等键的写操作；写操作只重试语句发出之前的错误
+type Data_Retry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单个请求重试的总时长，默认 15s
+	Timeout        *durationpb.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// 最多尝试次数，包括第一次；0 表示只受 timeout 限制，1 表示不重试
+	MaxAttempts   int32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Retry) Reset() {
+	*x = Data_Retry{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Retry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Retry) ProtoMessage() {}
+
+func (x *Data_Retry) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Retry.ProtoReflect.Descriptor instead.
+func (*Data_Retry) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Data_Retry) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Data_Retry) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *Data_Retry) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Data_Retry) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+// 写操作幂等键，结果保存在 redis 的 state_db 中
+type Data_Idempotency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 结果保存时长，窗口内相同的幂等键直接返回保存的结果，默认 24h
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// 请求执行期间占用幂等键的最长时间，默认 1m
+	LockTimeout   *durationpb.Duration `protobuf:"bytes,2,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"`
+	KeyPrefix     string               `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Idempotency) Reset() {
+	*x = Data_Idempotency{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Idempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Idempotency) ProtoMessage() {}
+
+func (x *Data_Idempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Idempotency.ProtoReflect.Descriptor instead.
+func (*Data_Idempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Data_Idempotency) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Idempotency) GetLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.LockTimeout
+	}
+	return nil
+}
+
+func (x *Data_Idempotency) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+// gRPC 健康检查，定期 ping 所有数据库主库和 redis
+type Data_Health struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 检查间隔，默认 5s
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// 单次 ping 超时，默认 2s
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 不可用时不影响整体状态的依赖，如 "redis"、"database/report"
+	OptionalDependencies []string `protobuf:"bytes,3,rep,name=optional_dependencies,json=optionalDependencies,proto3" json:"optional_dependencies,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Data_Health) Reset() {
+	*x = Data_Health{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Health) ProtoMessage() {}
+
+func (x *Data_Health) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Health.ProtoReflect.Descriptor instead.
+func (*Data_Health) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Data_Health) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Health) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Data_Health) GetOptionalDependencies() []string {
+	if x != nil {
+		return x.OptionalDependencies
+	}
+	return nil
+}
+
+// 慢查询日志，始终开启，写入单独的日志文件
+type Data_SlowQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 超过该耗时的 SQL 记为慢查询，默认 1s，小于 0 表示关闭
+	Threshold *durationpb.Duration `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// 日志文件，默认为 log.path 下的 datahub-slow.log，轮转参数与主日志相同
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// 对慢的 SELECT 执行 EXPLAIN，结果写入同一条日志
+	Explain bool `protobuf:"varint,3,opt,name=explain,proto3" json:"explain,omitempty"`
+	// 单次 EXPLAIN 的超时，默认 5s
+	ExplainTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=explain_timeout,json=explainTimeout,proto3" json:"explain_timeout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_SlowQuery) Reset() {
+	*x = Data_SlowQuery{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_SlowQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_SlowQuery) ProtoMessage() {}
+
+func (x *Data_SlowQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_SlowQuery.ProtoReflect.Descriptor instead.
+func (*Data_SlowQuery) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Data_SlowQuery) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *Data_SlowQuery) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Data_SlowQuery) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *Data_SlowQuery) GetExplainTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ExplainTimeout
+	}
+	return nil
+}
+
+// 连续出现连接类错误时熔断，熔断期间请求直接失败
+type Data_Database_CircuitBreaker struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 连续失败多少次后熔断，默认 5，小于 0 关闭熔断
+	FailureThreshold int32 `protobuf:"varint,1,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// 熔断持续时间，之后放行少量请求试探，默认 30s
+	OpenTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=open_timeout,json=openTimeout,proto3" json:"open_timeout,omitempty"`
+	// 试探期间同时放行的请求数，默认 1
+	HalfOpenRequests int32 `protobuf:"varint,3,opt,name=half_open_requests,json=halfOpenRequests,proto3" json:"half_open_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_Database_CircuitBreaker) Reset() {
+	*x = Data_Database_CircuitBreaker{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database_CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database_CircuitBreaker) ProtoMessage() {}
+
+func (x *Data_Database_CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database_CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*Data_Database_CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
+func (x *Data_Database_CircuitBreaker) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *Data_Database_CircuitBreaker) GetOpenTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.OpenTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database_CircuitBreaker) GetHalfOpenRequests() int32 {
+	if x != nil {
+		return x.HalfOpenRequests
+	}
+	return 0
+}
+
+// 缓存命名空间，每个命名空间对应一个 redis db
+type Data_Redis_Namespace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Db    int32                  `protobuf:"varint,2,opt,name=db,proto3" json:"db,omitempty"`
+	// 命名空间的默认缓存时间，请求未指定时使用
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	KeyPrefix     string               `protobuf:"bytes,4,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis_Namespace) Reset() {
+	*x = Data_Redis_Namespace{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis_Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis_Namespace) ProtoMessage() {}
+
+func (x *Data_Redis_Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis_Namespace.ProtoReflect.Descriptor instead.
+func (*Data_Redis_Namespace) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1, 0}
+}
+
+func (x *Data_Redis_Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_Redis_Namespace) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *Data_Redis_Namespace) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Redis_Namespace) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+type Data_Redis_TLS struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Enabled            bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CaFile             string                 `protobuf:"bytes,2,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	CertFile           string                 `protobuf:"bytes,3,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile            string                 `protobuf:"bytes,4,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	ServerName         string                 `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	InsecureSkipVerify bool                   `protobuf:"varint,6,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Data_Redis_TLS) Reset() {
+	*x = Data_Redis_TLS{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis_TLS) ProtoMessage() {}
+
+func (x *Data_Redis_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis_TLS.ProtoReflect.Descriptor instead.
+func (*Data_Redis_TLS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1, 1}
+}
+
+func (x *Data_Redis_TLS) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_Redis_TLS) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *Data_Redis_TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *Data_Redis_TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *Data_Redis_TLS) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *Data_Redis_TLS) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+type Data_Binlog_Table struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mysql 中的库名和表名
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// 缓存 key 中使用的数据库名称，即 databases 中的 name
+	DbName          string   `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CacheNamespaces []string `protobuf:"bytes,4,rep,name=cache_namespaces,json=cacheNamespaces,proto3" json:"cache_namespaces,omitempty"`
+	// 每一项是一组缓存字段，多个字段用逗号分隔，如 "tenant_id,device_id"
+	CacheKeys []string `protobuf:"bytes,5,rep,name=cache_keys,json=cacheKeys,proto3" json:"cache_keys,omitempty"`
+	// 有变更时清除整张表的缓存
+	FlushTable    bool `protobuf:"varint,6,opt,name=flush_table,json=flushTable,proto3" json:"flush_table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Binlog_Table) Reset() {
+	*x = Data_Binlog_Table{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Binlog_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Binlog_Table) ProtoMessage() {}
+
+func (x *Data_Binlog_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Binlog_Table.ProtoReflect.Descriptor instead.
+func (*Data_Binlog_Table) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 4, 0}
+}
+
+func (x *Data_Binlog_Table) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Data_Binlog_Table) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Data_Binlog_Table) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *Data_Binlog_Table) GetCacheNamespaces() []string {
+	if x != nil {
+		return x.CacheNamespaces
+	}
+	return nil
+}
+
+func (x *Data_Binlog_Table) GetCacheKeys() []string {
+	if x != nil {
+		return x.CacheKeys
+	}
+	return nil
+}
+
+func (x *Data_Binlog_Table) GetFlushTable() bool {
+	if x != nil {
+		return x.FlushTable
+	}
+	return false
+}
+
+var File_conf_conf_proto protoreflect.FileDescriptor
+
+const file_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x0fconf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xa9\x01\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03log\x18\x03 \x01(\v2\x0f.kratos.api.LogR\x03log\"\x89\x01\n" +
+	"\x03log\x18\x03 \x01(\v2\x0f.kratos.api.LogR\x03log\x12'\n" +
+	"\x05trace\x18\x04 \x01(\v2\x11.kratos.api.TraceR\x05trace\"\x92\x01\n" +
+	"\x05Trace\x12\x1a\n" +
+	"\bexporter\x18\x01 \x01(\tR\bexporter\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12!\n" +
+	"\fsample_ratio\x18\x05 \x01(\x01R\vsampleRatio\"\xf9\x01\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x16\n" +
 	"\x06expire\x18\x04 \x01(\x05R\x06expire\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06stdout\x18\x06 \x01(\bR\x06stdout\"\x86\x01\n" +
+	"\x06stdout\x18\x06 \x01(\bR\x06stdout\x123\n" +
+	"\x06levels\x18\a \x03(\v2\x1b.kratos.api.Log.LevelsEntryR\x06levels\x1a9\n" +
+	"\vLevelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe9\x01\n" +
 	"\x06Server\x12+\n" +
-	"\x04grpc\x18\x01 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1aO\n" +
+	"\x04grpc\x18\x01 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12.\n" +
+	"\x05admin\x18\x02 \x01(\v2\x18.kratos.api.Server.AdminR\x05admin\x1aO\n" +
 	"\x04GRPC\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x82\x02\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a1\n" +
+	"\x05Admin\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xb7!\n" +
 	"\x04Data\x127\n" +
 	"\tdatabases\x18\x01 \x03(\v2\x19.kratos.api.Data.DatabaseR\tdatabases\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a0\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12<\n" +
+	"\vlocal_cache\x18\x03 \x01(\v2\x1b.kratos.api.Data.LocalCacheR\n" +
+	"localCache\x12B\n" +
+	"\rcache_payload\x18\x04 \x01(\v2\x1d.kratos.api.Data.CachePayloadR\fcachePayload\x12/\n" +
+	"\x06binlog\x18\x05 \x01(\v2\x17.kratos.api.Data.BinlogR\x06binlog\x12>\n" +
+	"\rdrain_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fdrainTimeout\x12,\n" +
+	"\x05retry\x18\a \x01(\v2\x16.kratos.api.Data.RetryR\x05retry\x12>\n" +
+	"\vidempotency\x18\b \x01(\v2\x1c.kratos.api.Data.IdempotencyR\vidempotency\x12/\n" +
+	"\x06health\x18\t \x01(\v2\x17.kratos.api.Data.HealthR\x06health\x12S\n" +
+	"\x18transaction_grace_period\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x16transactionGracePeriod\x129\n" +
+	"\n" +
+	"slow_query\x18\v \x01(\v2\x1a.kratos.api.Data.SlowQueryR\tslowQuery\x1a\xd3\a\n" +
 	"\bDatabase\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03dsn\x18\x02 \x01(\tR\x03dsn\x1aa\n" +
+	"\x03dsn\x18\x02 \x01(\tR\x03dsn\x12\x16\n" +
+	"\x06driver\x18\x03 \x01(\tR\x06driver\x12!\n" +
+	"\freplica_dsns\x18\x04 \x03(\tR\vreplicaDsns\x12Q\n" +
+	"\x17replica_health_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x15replicaHealthInterval\x12$\n" +
+	"\x0emax_open_conns\x18\x06 \x01(\x05R\fmaxOpenConns\x12$\n" +
+	"\x0emax_idle_conns\x18\a \x01(\x05R\fmaxIdleConns\x12E\n" +
+	"\x11conn_max_lifetime\x18\b \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x12F\n" +
+	"\x12conn_max_idle_time\x18\t \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxIdleTime\x12B\n" +
+	"\x0fconnect_timeout\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x0econnectTimeout\x12<\n" +
+	"\fread_timeout\x18\v \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\f \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x126\n" +
+	"\x17max_concurrent_requests\x18\r \x01(\x05R\x15maxConcurrentRequests\x12?\n" +
+	"\x0emax_queue_wait\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\fmaxQueueWait\x12Q\n" +
+	"\x0fcircuit_breaker\x18\x0f \x01(\v2(.kratos.api.Data.Database.CircuitBreakerR\x0ecircuitBreaker\x1a\xa9\x01\n" +
+	"\x0eCircuitBreaker\x12+\n" +
+	"\x11failure_threshold\x18\x01 \x01(\x05R\x10failureThreshold\x12<\n" +
+	"\fopen_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vopenTimeout\x12,\n" +
+	"\x12half_open_requests\x18\x03 \x01(\x05R\x10halfOpenRequests\x1a\x9e\b\n" +
 	"\x05Redis\x12\x16\n" +
 	"\x06master\x18\x01 \x01(\tR\x06master\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12$\n" +
-	"\rsentinelAddrs\x18\x03 \x03(\tR\rsentinelAddrsB\x1cZ\x1adatahub/internal/conf;confb\x06proto3"
+	"\rsentinelAddrs\x18\x03 \x03(\tR\rsentinelAddrs\x12@\n" +
+	"\n" +
+	"namespaces\x18\x04 \x03(\v2 .kratos.api.Data.Redis.NamespaceR\n" +
+	"namespaces\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x14\n" +
+	"\x05addrs\x18\x06 \x03(\tR\x05addrs\x12\x1a\n" +
+	"\busername\x18\a \x01(\tR\busername\x12+\n" +
+	"\x11sentinel_username\x18\b \x01(\tR\x10sentinelUsername\x12+\n" +
+	"\x11sentinel_password\x18\t \x01(\tR\x10sentinelPassword\x12,\n" +
+	"\x03tls\x18\n" +
+	" \x01(\v2\x1a.kratos.api.Data.Redis.TLSR\x03tls\x12\x1b\n" +
+	"\tpool_size\x18\v \x01(\x05R\bpoolSize\x12$\n" +
+	"\x0emin_idle_conns\x18\f \x01(\x05R\fminIdleConns\x12<\n" +
+	"\fdial_timeout\x18\r \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
+	"\fread_timeout\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12<\n" +
+	"\fpool_timeout\x18\x10 \x01(\v2\x19.google.protobuf.DurationR\vpoolTimeout\x12\x1e\n" +
+	"\bstate_db\x18\x11 \x01(\x05H\x00R\astateDb\x88\x01\x01\x1a{\n" +
+	"\tNamespace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02db\x18\x02 \x01(\x05R\x02db\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x04 \x01(\tR\tkeyPrefix\x1a\xc3\x01\n" +
+	"\x03TLS\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x17\n" +
+	"\aca_file\x18\x02 \x01(\tR\x06caFile\x12\x1b\n" +
+	"\tcert_file\x18\x03 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x04 \x01(\tR\akeyFile\x12\x1f\n" +
+	"\vserver_name\x18\x05 \x01(\tR\n" +
+	"serverName\x120\n" +
+	"\x14insecure_skip_verify\x18\x06 \x01(\bR\x12insecureSkipVerifyB\v\n" +
+	"\t_state_db\x1a\xc0\x01\n" +
+	"\n" +
+	"LocalCache\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vmax_entries\x18\x02 \x01(\x05R\n" +
+	"maxEntries\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12-\n" +
+	"\x12invalidate_channel\x18\x05 \x01(\tR\x11invalidateChannel\x1a\x86\x01\n" +
+	"\fCachePayload\x12 \n" +
+	"\vcompression\x18\x01 \x01(\tR\vcompression\x12-\n" +
+	"\x12compress_threshold\x18\x02 \x01(\x05R\x11compressThreshold\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\tR\rschemaVersion\x1a\x81\x04\n" +
+	"\x06Binlog\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tserver_id\x18\x05 \x01(\rR\bserverId\x12\x16\n" +
+	"\x06flavor\x18\x06 \x01(\tR\x06flavor\x12%\n" +
+	"\x0echeckpoint_key\x18\a \x01(\tR\rcheckpointKey\x12J\n" +
+	"\x13checkpoint_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x12checkpointInterval\x125\n" +
+	"\x06tables\x18\t \x03(\v2\x1d.kratos.api.Data.Binlog.TableR\x06tables\x1a\xb9\x01\n" +
+	"\x05Table\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x17\n" +
+	"\adb_name\x18\x03 \x01(\tR\x06dbName\x12)\n" +
+	"\x10cache_namespaces\x18\x04 \x03(\tR\x0fcacheNamespaces\x12\x1d\n" +
+	"\n" +
+	"cache_keys\x18\x05 \x03(\tR\tcacheKeys\x12\x1f\n" +
+	"\vflush_table\x18\x06 \x01(\bR\n" +
+	"flushTable\x1a\xdf\x01\n" +
+	"\x05Retry\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12B\n" +
+	"\x0finitial_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\x1a\x97\x01\n" +
+	"\vIdempotency\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12<\n" +
+	"\flock_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vlockTimeout\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x03 \x01(\tR\tkeyPrefix\x1a\xa9\x01\n" +
+	"\x06Health\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x123\n" +
+	"\x15optional_dependencies\x18\x03 \x03(\tR\x14optionalDependencies\x1a\xb6\x01\n" +
+	"\tSlowQuery\x127\n" +
+	"\tthreshold\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tthreshold\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\aexplain\x18\x03 \x01(\bR\aexplain\x12B\n" +
+	"\x0fexplain_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eexplainTimeoutB\x1cZ\x1adatahub/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -471,30 +1898,84 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Log)(nil),                 // 1: kratos.api.Log
-	(*Server)(nil),              // 2: kratos.api.Server
-	(*Data)(nil),                // 3: kratos.api.Data
-	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 6: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Bootstrap)(nil),                    // 0: kratos.api.Bootstrap
+	(*Trace)(nil),                        // 1: kratos.api.Trace
+	(*Log)(nil),                          // 2: kratos.api.Log
+	(*Server)(nil),                       // 3: kratos.api.Server
+	(*Data)(nil),                         // 4: kratos.api.Data
+	nil,                                  // 5: kratos.api.Log.LevelsEntry
+	(*Server_GRPC)(nil),                  // 6: kratos.api.Server.GRPC
+	(*Server_Admin)(nil),                 // 7: kratos.api.Server.Admin
+	(*Data_Database)(nil),                // 8: kratos.api.Data.Database
+	(*Data_Redis)(nil),                   // 9: kratos.api.Data.Redis
+	(*Data_LocalCache)(nil),              // 10: kratos.api.Data.LocalCache
+	(*Data_CachePayload)(nil),            // 11: kratos.api.Data.CachePayload
+	(*Data_Binlog)(nil),                  // 12: kratos.api.Data.Binlog
+	(*Data_Retry)(nil),                   // 13: kratos.api.Data.Retry
+	(*Data_Idempotency)(nil),             // 14: kratos.api.Data.Idempotency
+	(*Data_Health)(nil),                  // 15: kratos.api.Data.Health
+	(*Data_SlowQuery)(nil),               // 16: kratos.api.Data.SlowQuery
+	(*Data_Database_CircuitBreaker)(nil), // 17: kratos.api.Data.Database.CircuitBreaker
+	(*Data_Redis_Namespace)(nil),         // 18: kratos.api.Data.Redis.Namespace
+	(*Data_Redis_TLS)(nil),               // 19: kratos.api.Data.Redis.TLS
+	(*Data_Binlog_Table)(nil),            // 20: kratos.api.Data.Binlog.Table
+	(*durationpb.Duration)(nil),          // 21: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	2, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	3, // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	1, // 2: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
-	4, // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	5, // 4: kratos.api.Data.databases:type_name -> kratos.api.Data.Database
-	6, // 5: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	7, // 6: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	4,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	2,  // 2: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
+	1,  // 3: kratos.api.Bootstrap.trace:type_name -> kratos.api.Trace
+	5,  // 4: kratos.api.Log.levels:type_name -> kratos.api.Log.LevelsEntry
+	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	7,  // 6: kratos.api.Server.admin:type_name -> kratos.api.Server.Admin
+	8,  // 7: kratos.api.Data.databases:type_name -> kratos.api.Data.Database
+	9,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	10, // 9: kratos.api.Data.local_cache:type_name -> kratos.api.Data.LocalCache
+	11, // 10: kratos.api.Data.cache_payload:type_name -> kratos.api.Data.CachePayload
+	12, // 11: kratos.api.Data.binlog:type_name -> kratos.api.Data.Binlog
+	21, // 12: kratos.api.Data.drain_timeout:type_name -> google.protobuf.Duration
+	13, // 13: kratos.api.Data.retry:type_name -> kratos.api.Data.Retry
+	14, // 14: kratos.api.Data.idempotency:type_name -> kratos.api.Data.Idempotency
+	15, // 15: kratos.api.Data.health:type_name -> kratos.api.Data.Health
+	21, // 16: kratos.api.Data.transaction_grace_period:type_name -> google.protobuf.Duration
+	16, // 17: kratos.api.Data.slow_query:type_name -> kratos.api.Data.SlowQuery
+	21, // 18: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	21, // 19: kratos.api.Data.Database.replica_health_interval:type_name -> google.protobuf.Duration
+	21, // 20: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	21, // 21: kratos.api.Data.Database.conn_max_idle_time:type_name -> google.protobuf.Duration
+	21, // 22: kratos.api.Data.Database.connect_timeout:type_name -> google.protobuf.Duration
+	21, // 23: kratos.api.Data.Database.read_timeout:type_name -> google.protobuf.Duration
+	21, // 24: kratos.api.Data.Database.write_timeout:type_name -> google.protobuf.Duration
+	21, // 25: kratos.api.Data.Database.max_queue_wait:type_name -> google.protobuf.Duration
+	17, // 26: kratos.api.Data.Database.circuit_breaker:type_name -> kratos.api.Data.Database.CircuitBreaker
+	18, // 27: kratos.api.Data.Redis.namespaces:type_name -> kratos.api.Data.Redis.Namespace
+	19, // 28: kratos.api.Data.Redis.tls:type_name -> kratos.api.Data.Redis.TLS
+	21, // 29: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	21, // 30: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	21, // 31: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	21, // 32: kratos.api.Data.Redis.pool_timeout:type_name -> google.protobuf.Duration
+	21, // 33: kratos.api.Data.LocalCache.ttl:type_name -> google.protobuf.Duration
+	21, // 34: kratos.api.Data.Binlog.checkpoint_interval:type_name -> google.protobuf.Duration
+	20, // 35: kratos.api.Data.Binlog.tables:type_name -> kratos.api.Data.Binlog.Table
+	21, // 36: kratos.api.Data.Retry.timeout:type_name -> google.protobuf.Duration
+	21, // 37: kratos.api.Data.Retry.initial_backoff:type_name -> google.protobuf.Duration
+	21, // 38: kratos.api.Data.Retry.max_backoff:type_name -> google.protobuf.Duration
+	21, // 39: kratos.api.Data.Idempotency.ttl:type_name -> google.protobuf.Duration
+	21, // 40: kratos.api.Data.Idempotency.lock_timeout:type_name -> google.protobuf.Duration
+	21, // 41: kratos.api.Data.Health.interval:type_name -> google.protobuf.Duration
+	21, // 42: kratos.api.Data.Health.timeout:type_name -> google.protobuf.Duration
+	21, // 43: kratos.api.Data.SlowQuery.threshold:type_name -> google.protobuf.Duration
+	21, // 44: kratos.api.Data.SlowQuery.explain_timeout:type_name -> google.protobuf.Duration
+	21, // 45: kratos.api.Data.Database.CircuitBreaker.open_timeout:type_name -> google.protobuf.Duration
+	21, // 46: kratos.api.Data.Redis.Namespace.ttl:type_name -> google.protobuf.Duration
+	47, // [47:47] is the sub-list for method output_type
+	47, // [47:47] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -502,13 +1983,14 @@ func file_conf_conf_proto_init() {
 	if File_conf_conf_proto != nil {
 		return
 	}
+	file_conf_conf_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
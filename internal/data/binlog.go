@@ -0,0 +1,396 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-mysql-org/go-mysql/canal"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-mysql-org/go-mysql/schema"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultBinlogCheckpointKey      = "datahub:binlog:checkpoint"
+	defaultBinlogCheckpointInterval = time.Second
+
+	binlogRetryInitialBackoff = time.Second
+	binlogRetryMaxBackoff     = 30 * time.Second
+)
+
+// BinlogInvalidator 订阅 mysql binlog（需要 ROW 格式），按配置清除被外部直接修改的行对应的缓存。
+// 位点保存在 redis 的 state_db 中，重启后从上次保存的位置继续。未启用时为 nil，Start 和 Stop 直接返回。
+type BinlogInvalidator struct {
+	c      *conf.Data_Binlog
+	cache  *CachingDatalayerRepo
+	store  redis.UniversalClient
+	tables map[string]*binlogTable // 键是 schema.table
+	log    *log.Helper
+
+	mu       sync.Mutex
+	canal    *canal.Canal
+	stopped  bool
+	lastSave time.Time
+}
+
+type binlogTable struct {
+	table      *v1.TableSchema
+	namespaces []*CacheNamespace
+	cacheKeys  [][]string // 每组缓存字段都已排序
+	flushTable bool
+}
+
+type binlogHandler struct {
+	canal.DummyEventHandler
+	b *BinlogInvalidator
+}
+
+func NewBinlogInvalidator(c *conf.Data, cache *RedisClient, caching *CachingDatalayerRepo, logger log.Logger) (*BinlogInvalidator, error) {
+	bc := c.Binlog
+	if bc == nil || !bc.Enabled {
+		return nil, nil
+	}
+	if bc.Addr == "" {
+		return nil, fmt.Errorf("binlog addr is required")
+	}
+	if len(bc.Tables) == 0 {
+		return nil, fmt.Errorf("binlog tables are required")
+	}
+
+	b := &BinlogInvalidator{
+		c:      bc,
+		cache:  caching,
+		tables: make(map[string]*binlogTable, len(bc.Tables)),
+		log:    log.NewHelper(logger),
+	}
+	for _, t := range bc.Tables {
+		if t.Schema == "" || t.Table == "" {
+			return nil, fmt.Errorf("binlog table schema and table are required")
+		}
+		dbName := t.DbName
+		if dbName == "" {
+			dbName = t.Schema
+		}
+		bt := &binlogTable{
+			table:      &v1.TableSchema{DbName: dbName, TableName: t.Table},
+			flushTable: t.FlushTable,
+		}
+		for _, name := range t.CacheNamespaces {
+			ns := cache.GetNamespace(name, v1.RedisDB_UNSPECIFIED)
+			if ns == nil {
+				return nil, fmt.Errorf("binlog table %s.%s: cache namespace %s not found", t.Schema, t.Table, name)
+			}
+			bt.namespaces = append(bt.namespaces, ns)
+		}
+		for _, key := range t.CacheKeys {
+			if fields := normalizeCacheFields(strings.Split(key, ",")); len(fields) > 0 {
+				bt.cacheKeys = append(bt.cacheKeys, fields)
+			}
+		}
+		if len(bt.namespaces) == 0 || (len(bt.cacheKeys) == 0 && !bt.flushTable) {
+			return nil, fmt.Errorf("binlog table %s.%s: cache_namespaces and cache_keys or flush_table are required", t.Schema, t.Table)
+		}
+		b.tables[t.Schema+"."+t.Table] = bt
+	}
+	// 位点没有过期时间，不能放在会被清空的缓存命名空间中
+	store, err := cache.stateClient(b.checkpointKey())
+	if err != nil {
+		return nil, fmt.Errorf("binlog checkpoint: %w", err)
+	}
+	b.store = store
+	return b, nil
+}
+
+// Start 持续同步 binlog，连接断开后按退避时间重连，直到 Stop 被调用
+func (b *BinlogInvalidator) Start(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	backoff := binlogRetryInitialBackoff
+	for {
+		err := b.run(ctx)
+		if b.isStopped() {
+			return nil
+		}
+		b.log.Errorf("binlog sync stopped: %v, retrying in %s", err, backoff)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, binlogRetryMaxBackoff)
+	}
+}
+
+func (b *BinlogInvalidator) Stop(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	b.stopped = true
+	c := b.canal
+	b.mu.Unlock()
+
+	if c != nil {
+		c.Close()
+		// 关闭后保存最后同步到的位点
+		if err := b.saveCheckpoint(ctx, c.SyncedPosition()); err != nil {
+			b.log.Errorf("save binlog checkpoint on stop error: %v", err)
+		}
+	}
+	return nil
+}
+
+func (b *BinlogInvalidator) isStopped() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stopped
+}
+
+func (b *BinlogInvalidator) run(ctx context.Context) error {
+	cfg := canal.NewDefaultConfig()
+	cfg.Addr = b.c.Addr
+	cfg.User = b.c.User
+	cfg.Password = b.c.Password
+	if b.c.ServerId > 0 {
+		cfg.ServerID = b.c.ServerId
+	}
+	if b.c.Flavor != "" {
+		cfg.Flavor = b.c.Flavor
+	}
+	// 只需要增量变更，不做全量 dump
+	cfg.Dump.ExecutionPath = ""
+	// 时间类型按 mysql 返回给客户端的字符串解析，TIMESTAMP 按进程所在时区显示
+	cfg.ParseTime = false
+	cfg.TimestampStringLocation = time.Local
+	cfg.IncludeTableRegex = make([]string, 0, len(b.tables))
+	for key := range b.tables {
+		cfg.IncludeTableRegex = append(cfg.IncludeTableRegex, "^"+regexp.QuoteMeta(key)+"$")
+	}
+
+	c, err := canal.NewCanal(cfg)
+	if err != nil {
+		return err
+	}
+	c.SetEventHandler(&binlogHandler{b: b})
+
+	b.mu.Lock()
+	if b.stopped {
+		b.mu.Unlock()
+		c.Close()
+		return nil
+	}
+	b.canal = c
+	b.mu.Unlock()
+
+	pos, err := b.loadCheckpoint(ctx)
+	if err != nil {
+		c.Close()
+		return err
+	}
+	if pos.Name == "" {
+		// 没有保存过位点时从当前位置开始
+		if pos, err = c.GetMasterPos(); err != nil {
+			c.Close()
+			return err
+		}
+		b.log.Infof("no binlog checkpoint found, starting from current position %s", pos)
+	} else {
+		b.log.Infof("resuming binlog sync from checkpoint %s", pos)
+	}
+	return c.RunFrom(pos)
+}
+
+func (b *BinlogInvalidator) loadCheckpoint(ctx context.Context) (mysql.Position, error) {
+	var pos mysql.Position
+	data, err := b.store.Get(ctx, b.checkpointKey()).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return pos, nil
+	}
+	if err != nil {
+		return pos, fmt.Errorf("load binlog checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(data, &pos); err != nil {
+		return pos, fmt.Errorf("decode binlog checkpoint: %w", err)
+	}
+	return pos, nil
+}
+
+func (b *BinlogInvalidator) saveCheckpoint(ctx context.Context, pos mysql.Position) error {
+	if pos.Name == "" {
+		return nil
+	}
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+	return b.store.Set(ctx, b.checkpointKey(), data, 0).Err()
+}
+
+func (b *BinlogInvalidator) checkpointKey() string {
+	if b.c.CheckpointKey != "" {
+		return b.c.CheckpointKey
+	}
+	return defaultBinlogCheckpointKey
+}
+
+func (b *BinlogInvalidator) checkpointInterval() time.Duration {
+	if d := b.c.CheckpointInterval.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultBinlogCheckpointInterval
+}
+
+func (h *binlogHandler) OnRow(e *canal.RowsEvent) error {
+	b := h.b
+	bt, ok := b.tables[e.Table.Schema+"."+e.Table.Name]
+	if !ok {
+		return nil
+	}
+	ctx := context.Background()
+
+	// 清除失败时返回错误中断同步，位点停在失败的事件之前，重连后从上次保存的位点重新处理
+	for _, ns := range bt.namespaces {
+		if bt.flushTable {
+			if _, err := b.cache.invalidatePrefix(ctx, ns, bt.table, b.cache.buildTablePrefix(ns, bt.table)); err != nil {
+				return fmt.Errorf("binlog flush cache for table %s.%s: %w", e.Table.Schema, e.Table.Name, err)
+			}
+			continue
+		}
+
+		// update 事件中前后两行都在 Rows 中，修改前后的 key 都需要清除
+		seen := make(map[string]struct{})
+		cacheKeys := make([]string, 0, len(e.Rows)*len(bt.cacheKeys))
+		for _, row := range e.Rows {
+			for _, fields := range bt.cacheKeys {
+				values, ok := binlogCacheValues(e.Table, row, fields)
+				if !ok {
+					continue
+				}
+				cacheKey := b.cache.buildCacheKey(ns, bt.table, fields, values)
+				if _, ok := seen[cacheKey]; ok {
+					continue
+				}
+				seen[cacheKey] = struct{}{}
+				cacheKeys = append(cacheKeys, cacheKey)
+			}
+		}
+		if err := b.cache.invalidateCache(ctx, ns, bt.table, cacheKeys...); err != nil {
+			return fmt.Errorf("binlog invalidate cache for table %s.%s: %w", e.Table.Schema, e.Table.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h *binlogHandler) OnPosSynced(_ *replication.EventHeader, pos mysql.Position, _ mysql.GTIDSet, force bool) error {
+	b := h.b
+	if !force && time.Since(b.lastSave) < b.checkpointInterval() {
+		return nil
+	}
+	if err := b.saveCheckpoint(context.Background(), pos); err != nil {
+		// 保存失败不中断同步，重启后最多重复处理一段 binlog
+		b.log.Errorf("save binlog checkpoint %s error: %v", pos, err)
+		return nil
+	}
+	b.lastSave = time.Now()
+	return nil
+}
+
+func (h *binlogHandler) String() string {
+	return "BinlogInvalidator"
+}
+
+// 取出 binlog 行中缓存字段的值，转换成与查询条件相同的类型，保证生成的 key 一致
+func binlogCacheValues(table *schema.Table, row []interface{}, fields []string) ([]any, bool) {
+	values := make([]any, len(fields))
+	for i, field := range fields {
+		idx := table.FindColumn(field)
+		if idx < 0 || idx >= len(row) {
+			return nil, false
+		}
+		value, ok := binlogCacheValue(&table.Columns[idx], row[idx])
+		if !ok {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
+
+// 查询条件中的数字都是 float64，binlog 中的整数和定点数需要转换
+func binlogCacheValue(col *schema.TableColumn, value interface{}) (any, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case []byte:
+		return string(v), true
+	case string:
+		if col.Type == schema.TYPE_DECIMAL {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, false
+			}
+			return f, true
+		}
+		return v, true
+	case time.Time:
+		// 与 mysql 返回的字符串格式一致
+		switch col.Type {
+		case schema.TYPE_DATE:
+			return v.Format(time.DateOnly), true
+		case schema.TYPE_DATETIME, schema.TYPE_TIMESTAMP:
+			return v.Format(binlogTimeFormat(col)), true
+		}
+		return nil, false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// DATETIME(n)、TIMESTAMP(n) 的小数秒位数与列定义一致，不足补零
+func binlogTimeFormat(col *schema.TableColumn) string {
+	layout := time.DateTime
+	start := strings.IndexByte(col.RawType, '(')
+	end := strings.IndexByte(col.RawType, ')')
+	if start < 0 || end <= start {
+		return layout
+	}
+	n, err := strconv.Atoi(col.RawType[start+1 : end])
+	if err != nil || n <= 0 || n > 6 {
+		return layout
+	}
+	return layout + "." + strings.Repeat("0", n)
+}
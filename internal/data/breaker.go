@@ -0,0 +1,347 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerOpenTimeout      = 30 * time.Second
+	defaultBreakerHalfOpenRequests = 1
+)
+
+type breakerState int32
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// databaseGuard 是单个数据库的并发名额（bulkhead）和熔断器，
+// 一个数据库变慢或不可用时快速失败，不影响其他数据库的请求。热更新时原地修改参数，保留熔断状态和已占用的名额
+type databaseGuard struct {
+	name     string
+	inFlight atomic.Int32
+	log      *log.Helper
+
+	mu        sync.Mutex
+	limit     int // 0 表示不限制并发
+	used      int
+	waiting   int
+	freed     chan struct{} // 有名额释放或上限调整时关闭，唤醒等待的请求
+	queueWait time.Duration
+	breaker   *circuitBreaker // nil 表示关闭熔断
+}
+
+// circuitBreaker 在连续出现连接类错误后熔断，open_timeout 后进入半开状态放行少量请求试探
+type circuitBreaker struct {
+	name string
+	log  *log.Helper
+
+	mu            sync.Mutex
+	threshold     int
+	openTimeout   time.Duration
+	halfOpenLimit int
+	state         breakerState
+	failures      int
+	openedAt      time.Time
+	probes        int // 半开状态下正在执行的试探请求
+}
+
+func newDatabaseGuard(source *conf.Data_Database, logger log.Logger) *databaseGuard {
+	g := &databaseGuard{name: source.Name, log: log.NewHelper(logger), freed: make(chan struct{})}
+	g.update(source)
+	return g
+}
+
+// update 按新的配置修改并发上限、排队时间和熔断参数
+func (g *databaseGuard) update(source *conf.Data_Database) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.limit = max(int(source.MaxConcurrentRequests), 0)
+	g.queueWait = source.MaxQueueWait.AsDuration()
+	// 上限可能调大，唤醒等待的请求重新检查
+	g.wakeLocked()
+
+	bc := source.CircuitBreaker
+	threshold := int(bc.GetFailureThreshold())
+	if threshold < 0 {
+		g.breaker = nil
+		return
+	}
+	if threshold == 0 {
+		threshold = defaultBreakerFailureThreshold
+	}
+	openTimeout := bc.GetOpenTimeout().AsDuration()
+	if openTimeout <= 0 {
+		openTimeout = defaultBreakerOpenTimeout
+	}
+	halfOpenLimit := int(bc.GetHalfOpenRequests())
+	if halfOpenLimit <= 0 {
+		halfOpenLimit = defaultBreakerHalfOpenRequests
+	}
+	if g.breaker == nil {
+		g.breaker = &circuitBreaker{name: source.Name, log: g.log}
+	}
+	g.breaker.configure(threshold, openTimeout, halfOpenLimit)
+}
+
+// resetBreaker 连接参数变化后之前的失败不再有意义，熔断器回到关闭状态
+func (g *databaseGuard) resetBreaker() {
+	g.mu.Lock()
+	b := g.breaker
+	g.mu.Unlock()
+	b.reset()
+}
+
+// acquire 检查熔断状态并占用一个并发名额，成功时返回的 done 必须以数据库操作的结果调用一次
+func (g *databaseGuard) acquire(ctx context.Context) (func(error), error) {
+	g.mu.Lock()
+	b := g.breaker
+	g.mu.Unlock()
+
+	probe, err := b.allow()
+	if err != nil {
+		return nil, err
+	}
+	if err := g.wait(ctx); err != nil {
+		b.cancel(probe)
+		return nil, err
+	}
+	g.inFlight.Add(1)
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			g.inFlight.Add(-1)
+			g.release()
+			// 调用方取消或超时导致的失败不能说明数据库不可用，不计入熔断
+			if err != nil && ctx.Err() != nil {
+				b.cancel(probe)
+				return
+			}
+			b.record(probe, err)
+		})
+	}, nil
+}
+
+// wait 占用一个并发名额。不限制并发时也计数，之后调小上限时已占用的名额仍然有效
+func (g *databaseGuard) wait(ctx context.Context) error {
+	var timeout <-chan time.Time
+	for {
+		g.mu.Lock()
+		if g.limit == 0 || g.used < g.limit {
+			g.used++
+			g.mu.Unlock()
+			return nil
+		}
+		if g.queueWait <= 0 {
+			g.mu.Unlock()
+			return g.full()
+		}
+		if timeout == nil {
+			timer := time.NewTimer(g.queueWait)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		freed := g.freed
+		g.waiting++
+		g.mu.Unlock()
+
+		var err error
+		select {
+		case <-freed:
+		case <-timeout:
+			err = g.full()
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		g.mu.Lock()
+		g.waiting--
+		g.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (g *databaseGuard) full() error {
+	return errors.ServiceUnavailable(v1.ReasonUnavailable, fmt.Sprintf("database '%s' has too many concurrent requests", g.name))
+}
+
+func (g *databaseGuard) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.used--
+	g.wakeLocked()
+}
+
+// 调用方需持有 g.mu
+func (g *databaseGuard) wakeLocked() {
+	if g.waiting > 0 {
+		close(g.freed)
+		g.freed = make(chan struct{})
+	}
+}
+
+// maxConcurrent 返回当前的并发上限，0 表示不限制
+func (g *databaseGuard) maxConcurrent() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.limit
+}
+
+func (g *databaseGuard) state() string {
+	g.mu.Lock()
+	b := g.breaker
+	g.mu.Unlock()
+	if b == nil {
+		return "disabled"
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState(time.Now()).String()
+}
+
+func (b *circuitBreaker) configure(threshold int, openTimeout time.Duration, halfOpenLimit int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.threshold, b.openTimeout, b.halfOpenLimit = threshold, openTimeout, halfOpenLimit
+}
+
+func (b *circuitBreaker) reset() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state != breakerClosed {
+		b.log.Infof("circuit breaker of database %s is closed after connection change", b.name)
+	}
+	b.state, b.failures = breakerClosed, 0
+}
+
+// allow 判断请求能否执行，第一个返回值表示是否是半开状态下的试探请求
+func (b *circuitBreaker) allow() (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState(time.Now()) {
+	case breakerOpen:
+		return false, errors.ServiceUnavailable(v1.ReasonUnavailable, fmt.Sprintf("database '%s' is unavailable: circuit breaker is open", b.name))
+	case breakerHalfOpen:
+		if b.state == breakerOpen {
+			b.state = breakerHalfOpen
+			b.log.Infof("circuit breaker of database %s is half open", b.name)
+		}
+		if b.probes >= b.halfOpenLimit {
+			return false, errors.ServiceUnavailable(v1.ReasonUnavailable, fmt.Sprintf("database '%s' is unavailable: circuit breaker is half open", b.name))
+		}
+		b.probes++
+		return true, nil
+	}
+	return false, nil
+}
+
+// 熔断超时后视为半开
+func (b *circuitBreaker) currentState(now time.Time) breakerState {
+	if b.state == breakerOpen && now.Sub(b.openedAt) >= b.openTimeout {
+		return breakerHalfOpen
+	}
+	return b.state
+}
+
+// record 记录请求结果，只有服务端或网络的连接类错误计为失败，其余错误说明数据库可以访问；
+// 上下文取消或超时既不计为失败也不计为成功
+func (b *circuitBreaker) record(probe bool, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probes--
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	if !IsConnError(err) {
+		if b.state != breakerClosed && probe {
+			b.state, b.failures = breakerClosed, 0
+			b.log.Infof("circuit breaker of database %s is closed", b.name)
+		} else if b.state == breakerClosed {
+			b.failures = 0
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || (b.state == breakerClosed && b.failures >= b.threshold) {
+		b.state, b.openedAt = breakerOpen, time.Now()
+		b.log.Warnf("circuit breaker of database %s is open after %d connection errors: %v", b.name, b.failures, err)
+	}
+}
+
+// cancel 释放未执行的试探名额
+func (b *circuitBreaker) cancel(probe bool) {
+	if b == nil || !probe {
+		return
+	}
+	b.mu.Lock()
+	b.probes--
+	b.mu.Unlock()
+}
+
+// acquire 占用数据库的并发名额并检查熔断状态，数据库未配置时返回 NotFound
+func (d *Data) acquire(ctx context.Context, dbName string) (func(error), error) {
+	d.dbMu.RLock()
+	g, ok := d.guards[dbName]
+	d.dbMu.RUnlock()
+	if !ok {
+		return nil, errDatabaseNotFound(dbName)
+	}
+	return g.acquire(ctx)
+}
+
+// guarded 在数据库的并发名额和熔断器保护下执行 op，op 应返回数据库的原始错误
+func (d *Data) guarded(ctx context.Context, dbName string, op func() error) error {
+	done, err := d.acquire(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	err = op()
+	done(err)
+	return err
+}
+
+// 已经是带状态码的错误，直接返回给调用方
+func isStatusError(err error) bool {
+	var se *errors.Error
+	return errors.As(err, &se)
+}
@@ -0,0 +1,185 @@
+package data
+
+import (
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 缓存内容格式：magic(2) | 版本(1) | 压缩算法(1) | 结构指纹(8) | 内容
+const (
+	cachePayloadMagic0    byte = 'D'
+	cachePayloadMagic1    byte = 'H'
+	cachePayloadVersion   byte = 1
+	cachePayloadHeaderLen      = 12
+
+	cacheCompressionNone   byte = 0
+	cacheCompressionSnappy byte = 1
+	cacheCompressionZstd   byte = 2
+
+	defaultCompressThreshold = 1024
+)
+
+// errCachePayloadStale 表示缓存条目是旧格式或结构指纹不一致，按未命中处理
+var errCachePayloadStale = errors.New("stale cache payload")
+
+// CacheCodec 负责缓存内容的编码和解码
+type CacheCodec struct {
+	compression byte
+	threshold   int
+	fingerprint uint64
+
+	zenc *zstd.Encoder
+	zdec *zstd.Decoder
+}
+
+func NewCacheCodec(c *conf.Data) (*CacheCodec, func(), error) {
+	p := c.CachePayload
+	if p == nil {
+		p = &conf.Data_CachePayload{}
+	}
+
+	codec := &CacheCodec{
+		threshold:   int(p.CompressThreshold),
+		fingerprint: cachePayloadFingerprint(p.SchemaVersion),
+	}
+	if codec.threshold <= 0 {
+		codec.threshold = defaultCompressThreshold
+	}
+	switch strings.ToLower(p.Compression) {
+	case "", "none":
+		codec.compression = cacheCompressionNone
+	case "snappy":
+		codec.compression = cacheCompressionSnappy
+	case "zstd":
+		codec.compression = cacheCompressionZstd
+	default:
+		return nil, nil, fmt.Errorf("unsupported cache payload compression: %s", p.Compression)
+	}
+
+	// 解码器总是创建，以便读取切换压缩算法之前写入的条目
+	zdec, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	zenc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	if err != nil {
+		zdec.Close()
+		return nil, nil, err
+	}
+	codec.zdec, codec.zenc = zdec, zenc
+
+	cleanup := func() {
+		_ = zenc.Close()
+		zdec.Close()
+	}
+	return codec, cleanup, nil
+}
+
+func (c *CacheCodec) Encode(resp *v1.QueryResponse) ([]byte, error) {
+	body, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	compression := cacheCompressionNone
+	if len(body) >= c.threshold {
+		compression = c.compression
+	}
+
+	header := make([]byte, cachePayloadHeaderLen, cachePayloadHeaderLen+len(body))
+	header[0], header[1] = cachePayloadMagic0, cachePayloadMagic1
+	header[2] = cachePayloadVersion
+	header[3] = compression
+	binary.BigEndian.PutUint64(header[4:], c.fingerprint)
+
+	switch compression {
+	case cacheCompressionSnappy:
+		return append(header, snappy.Encode(nil, body)...), nil
+	case cacheCompressionZstd:
+		return c.zenc.EncodeAll(body, header), nil
+	default:
+		return append(header, body...), nil
+	}
+}
+
+func (c *CacheCodec) Decode(data []byte) (*v1.QueryResponse, error) {
+	// 0x44 是 end group 标记，不会出现在合法 proto 数据的开头，可以与旧的无头格式区分
+	if len(data) < cachePayloadHeaderLen || data[0] != cachePayloadMagic0 || data[1] != cachePayloadMagic1 {
+		return nil, fmt.Errorf("%w: missing header", errCachePayloadStale)
+	}
+	if data[2] != cachePayloadVersion {
+		return nil, fmt.Errorf("%w: format version %d", errCachePayloadStale, data[2])
+	}
+	if fp := binary.BigEndian.Uint64(data[4:cachePayloadHeaderLen]); fp != c.fingerprint {
+		return nil, fmt.Errorf("%w: fingerprint %016x, want %016x", errCachePayloadStale, fp, c.fingerprint)
+	}
+
+	body := data[cachePayloadHeaderLen:]
+	var err error
+	switch data[3] {
+	case cacheCompressionNone:
+	case cacheCompressionSnappy:
+		body, err = snappy.Decode(nil, body)
+	case cacheCompressionZstd:
+		body, err = c.zdec.DecodeAll(body, nil)
+	default:
+		err = fmt.Errorf("unknown compression %d", data[3])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response v1.QueryResponse
+	if err := proto.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// 根据 QueryResponse 及其引用消息的字段定义和配置的 schema_version 计算指纹
+func cachePayloadFingerprint(schemaVersion string) uint64 {
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "schema_version=%s;", schemaVersion)
+
+	seen := make(map[protoreflect.FullName]bool)
+	var walk func(md protoreflect.MessageDescriptor)
+	walk = func(md protoreflect.MessageDescriptor) {
+		if seen[md.FullName()] {
+			return
+		}
+		seen[md.FullName()] = true
+
+		fields := md.Fields()
+		list := make([]protoreflect.FieldDescriptor, 0, fields.Len())
+		for i := 0; i < fields.Len(); i++ {
+			list = append(list, fields.Get(i))
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].Number() < list[j].Number() })
+
+		_, _ = fmt.Fprintf(h, "%s{", md.FullName())
+		for _, fd := range list {
+			_, _ = fmt.Fprintf(h, "%d:%s:%s:%s;", fd.Number(), fd.Name(), fd.Kind(), fd.Cardinality())
+			if fd.IsMap() {
+				if v := fd.MapValue(); v.Message() != nil {
+					walk(v.Message())
+				}
+			} else if fd.Message() != nil {
+				walk(fd.Message())
+			}
+		}
+		_, _ = fmt.Fprint(h, "}")
+	}
+	walk((&v1.QueryResponse{}).ProtoReflect().Descriptor())
+	return h.Sum64()
+}
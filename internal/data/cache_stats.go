@@ -0,0 +1,151 @@
+package data
+
+import (
+	v1 "datahub/api/datalayer/v1"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type cacheStatsKey struct {
+	namespace string
+	db        string
+	table     string
+}
+
+type cacheCounters struct {
+	hits              atomic.Int64
+	localHits         atomic.Int64
+	misses            atomic.Int64
+	fillErrors        atomic.Int64
+	unmarshalFailures atomic.Int64
+	invalidations     atomic.Int64
+	bytesServed       atomic.Int64
+}
+
+// CacheStats 按命名空间、数据库和表统计缓存的命中情况，同时作为 prometheus 的 Collector
+type CacheStats struct {
+	mu       sync.RWMutex
+	counters map[cacheStatsKey]*cacheCounters
+	data     *Data
+}
+
+var (
+	cacheStatsLabels = []string{"namespace", "db", "table"}
+
+	cacheHitsDesc = prometheus.NewDesc("datahub_cache_hits_total",
+		"Cache hits served from the in-process cache or Redis.", cacheStatsLabels, nil)
+	cacheLocalHitsDesc = prometheus.NewDesc("datahub_cache_local_hits_total",
+		"Cache hits served from the in-process cache.", cacheStatsLabels, nil)
+	cacheMissesDesc = prometheus.NewDesc("datahub_cache_misses_total",
+		"Cache lookups that fell through to the database.", cacheStatsLabels, nil)
+	cacheFillErrorsDesc = prometheus.NewDesc("datahub_cache_fill_errors_total",
+		"Failures writing database results back to the cache.", cacheStatsLabels, nil)
+	cacheUnmarshalFailuresDesc = prometheus.NewDesc("datahub_cache_unmarshal_failures_total",
+		"Cached payloads that could not be decoded.", cacheStatsLabels, nil)
+	cacheInvalidationsDesc = prometheus.NewDesc("datahub_cache_invalidations_total",
+		"Cache keys removed by writes or cache administration.", cacheStatsLabels, nil)
+	cacheBytesServedDesc = prometheus.NewDesc("datahub_cache_served_bytes_total",
+		"Payload bytes returned from cache hits.", cacheStatsLabels, nil)
+)
+
+func NewCacheStats(d *Data) (*CacheStats, error) {
+	s := &CacheStats{counters: make(map[cacheStatsKey]*cacheCounters), data: d}
+	if err := prometheus.Register(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// get 返回表对应的计数器，不存在时创建；table 为空表示命名空间级别的操作。
+// 未配置的数据库和不存在的表记在 other 下，避免客户端传入任意名称时计数器无限增长
+func (s *CacheStats) get(namespace string, table *v1.TableSchema) *cacheCounters {
+	key := cacheStatsKey{namespace: namespace}
+	if table != nil {
+		key.db, key.table = table.DbName, table.TableName
+		if key.db != "" && !s.data.HasDatabase(key.db) {
+			key.db = otherLabel
+		}
+		if key.table != "" && !s.data.HasTable(key.db, key.table) {
+			key.table = otherLabel
+		}
+	}
+
+	s.mu.RLock()
+	c, ok := s.counters[key]
+	s.mu.RUnlock()
+	if ok {
+		return c
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok = s.counters[key]; !ok {
+		c = &cacheCounters{}
+		s.counters[key] = c
+	}
+	return c
+}
+
+// Snapshot 返回符合过滤条件的统计，空条件匹配全部
+func (s *CacheStats) Snapshot(namespace, db, table string) []*v1.CacheTableStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := make([]*v1.CacheTableStats, 0, len(s.counters))
+	for key, c := range s.counters {
+		if (namespace != "" && key.namespace != namespace) || (db != "" && key.db != db) || (table != "" && key.table != table) {
+			continue
+		}
+		stats = append(stats, &v1.CacheTableStats{
+			CacheNamespace:    key.namespace,
+			DbName:            key.db,
+			TableName:         key.table,
+			Hits:              c.hits.Load(),
+			LocalHits:         c.localHits.Load(),
+			Misses:            c.misses.Load(),
+			FillErrors:        c.fillErrors.Load(),
+			UnmarshalFailures: c.unmarshalFailures.Load(),
+			Invalidations:     c.invalidations.Load(),
+			BytesServed:       c.bytesServed.Load(),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].CacheNamespace != stats[j].CacheNamespace {
+			return stats[i].CacheNamespace < stats[j].CacheNamespace
+		}
+		if stats[i].DbName != stats[j].DbName {
+			return stats[i].DbName < stats[j].DbName
+		}
+		return stats[i].TableName < stats[j].TableName
+	})
+	return stats
+}
+
+func (s *CacheStats) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cacheHitsDesc
+	ch <- cacheLocalHitsDesc
+	ch <- cacheMissesDesc
+	ch <- cacheFillErrorsDesc
+	ch <- cacheUnmarshalFailuresDesc
+	ch <- cacheInvalidationsDesc
+	ch <- cacheBytesServedDesc
+}
+
+func (s *CacheStats) Collect(ch chan<- prometheus.Metric) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for key, c := range s.counters {
+		labels := []string{key.namespace, key.db, key.table}
+		ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(c.hits.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheLocalHitsDesc, prometheus.CounterValue, float64(c.localHits.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, float64(c.misses.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheFillErrorsDesc, prometheus.CounterValue, float64(c.fillErrors.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheUnmarshalFailuresDesc, prometheus.CounterValue, float64(c.unmarshalFailures.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheInvalidationsDesc, prometheus.CounterValue, float64(c.invalidations.Load()), labels...)
+		ch <- prometheus.MustNewConstMetric(cacheBytesServedDesc, prometheus.CounterValue, float64(c.bytesServed.Load()), labels...)
+	}
+}
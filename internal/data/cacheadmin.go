@@ -0,0 +1,285 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/biz"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const flushScanCount = 500
+
+type CacheAdminRepo struct {
+	cache *CachingDatalayerRepo
+	log   *log.Helper
+}
+
+func NewCacheAdminRepo(cache *CachingDatalayerRepo, logger log.Logger) biz.CacheAdminRepo {
+	return &CacheAdminRepo{
+		cache: cache,
+		log:   log.NewHelper(logger),
+	}
+}
+
+var _ biz.CacheAdminRepo = (*CacheAdminRepo)(nil)
+
+func (r *CacheAdminRepo) InspectCache(ctx context.Context, req *v1.InspectCacheRequest) (*v1.InspectCacheResponse, error) {
+	ns, err := r.getNamespace(req.CacheNamespace)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey, err := r.buildCacheKey(ns, req.Table, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.InspectCacheResponse{
+		CacheKey:     cacheKey,
+		InLocalCache: r.cache.local.Contains(r.cache.buildLocalCacheKey(ns, cacheKey)),
+	}
+
+	data, err := ns.Client.Get(ctx, cacheKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return resp, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("inspect cache key %s error: %v", cacheKey, err)
+		return nil, errors.InternalServer(v1.ReasonCacheAdminFailed, err.Error())
+	}
+	resp.Exists = true
+	resp.SizeBytes = int64(len(data))
+
+	ttl, err := ns.Client.TTL(ctx, cacheKey).Result()
+	if err != nil {
+		return nil, errors.InternalServer(v1.ReasonCacheAdminFailed, err.Error())
+	}
+	if ttl > 0 {
+		resp.TtlSeconds = int64(ttl / time.Second)
+	} else {
+		resp.TtlSeconds = -1
+	}
+
+	value, err := r.cache.decodeCachedResponse(data)
+	if err != nil {
+		resp.DecodeError = err.Error()
+	} else {
+		resp.Value = value
+	}
+	return resp, nil
+}
+
+func (r *CacheAdminRepo) EvictCache(ctx context.Context, req *v1.EvictCacheRequest) (*v1.EvictCacheResponse, error) {
+	ns, err := r.getNamespace(req.CacheNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKeys := make([]string, 0, len(req.Keys)+len(req.CacheKeys))
+	for _, key := range req.Keys {
+		cacheKey, err := r.buildCacheKey(ns, req.Table, key)
+		if err != nil {
+			return nil, err
+		}
+		cacheKeys = append(cacheKeys, cacheKey)
+	}
+	for _, cacheKey := range req.CacheKeys {
+		if cacheKey != "" {
+			cacheKeys = append(cacheKeys, cacheKey)
+		}
+	}
+	if len(cacheKeys) == 0 {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "keys or cache_keys required")
+	}
+
+	evicted, err := deleteKeys(ctx, ns.Client, cacheKeys...)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("evict cache keys %v error: %v", cacheKeys, err)
+		return nil, errors.InternalServer(v1.ReasonCacheAdminFailed, err.Error())
+	}
+	for _, cacheKey := range cacheKeys {
+		r.cache.local.Invalidate(ctx, r.cache.buildLocalCacheKey(ns, cacheKey))
+	}
+	r.cache.stats.get(ns.Name, req.Table).invalidations.Add(evicted)
+
+	r.log.WithContext(ctx).Infof("evicted %d cache keys in namespace %s", evicted, ns.Name)
+	return &v1.EvictCacheResponse{Evicted: evicted}, nil
+}
+
+func (r *CacheAdminRepo) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	ns, err := r.getNamespace(req.CacheNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与 buildCacheKey 的格式保持一致：[prefix]db:table:...
+	if req.Table != nil && req.Table.TableName != "" && req.Table.DbName == "" {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table db_name required")
+	}
+	prefix := r.cache.buildTablePrefix(ns, req.Table)
+	if prefix == "" && !r.cache.cache.ownsDB(ns) {
+		// 没有前缀时会扫描整个 db，只有命名空间独占该 db 时才允许，避免误删其他命名空间和幂等记录等非缓存数据
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, fmt.Sprintf("cache namespace %s has no key_prefix and shares redis db %d, table required", ns.Name, ns.DB))
+	}
+	deleted, err := r.cache.invalidatePrefix(ctx, ns, req.Table, prefix)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("flush cache prefix %s error after deleting %d keys: %v", prefix, deleted, err)
+		return nil, errors.InternalServer(v1.ReasonCacheAdminFailed, err.Error())
+	}
+
+	r.log.WithContext(ctx).Infof("flushed %d cache keys with prefix %s in namespace %s", deleted, prefix, ns.Name)
+	return &v1.FlushCacheResponse{Deleted: deleted}, nil
+}
+
+func (r *CacheAdminRepo) WarmCache(ctx context.Context, req *v1.WarmCacheRequest) (*v1.WarmCacheResponse, error) {
+	ns, err := r.getNamespace(req.CacheNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if req.Table == nil {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
+	}
+
+	resp := &v1.WarmCacheResponse{}
+	for i, key := range req.Keys {
+		cacheKey, err := r.buildCacheKey(ns, req.Table, key)
+		if err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("key %d: %v", i, err))
+			continue
+		}
+
+		// 先删除旧条目，再走缓存查询路径重新写入
+		deleted, err := deleteKeys(ctx, ns.Client, cacheKey)
+		if err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("key %s: %v", cacheKey, err))
+			continue
+		}
+		r.cache.local.Invalidate(ctx, r.cache.buildLocalCacheKey(ns, cacheKey))
+		r.cache.stats.get(ns.Name, req.Table).invalidations.Add(deleted)
+
+		fields := sortedRowFields(key)
+		conds := make([]*v1.WhereClause, 0, len(fields))
+		for _, field := range fields {
+			conds = append(conds, &v1.WhereClause{ClauseType: &v1.WhereClause_Condition{Condition: &v1.Condition{
+				Field:       field,
+				Operator:    v1.Operator_EQ,
+				OperandType: &v1.Condition_LiteralValue{LiteralValue: key.Fields[field]},
+			}}})
+		}
+		queryResp, err := r.cache.Query(ctx, &v1.QueryRequest{
+			Table: req.Table,
+			WhereClause: &v1.WhereClause{ClauseType: &v1.WhereClause_NestedClause{NestedClause: &v1.NestedClause{
+				LogicalOperator: v1.LogicalOperator_AND,
+				Clauses:         conds,
+			}}},
+			CacheByField:    fields,
+			CacheTtlSeconds: req.CacheTtlSeconds,
+			CacheNamespace:  ns.Name,
+		})
+		if err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("key %s: %v", cacheKey, err))
+			continue
+		}
+		if isEmptyResponse(queryResp) {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("key %s: no rows found, not cached", cacheKey))
+			continue
+		}
+		resp.Warmed++
+	}
+
+	r.log.WithContext(ctx).Infof("warmed %d/%d cache keys in namespace %s", resp.Warmed, len(req.Keys), ns.Name)
+	return resp, nil
+}
+
+func (r *CacheAdminRepo) GetCacheStats(ctx context.Context, req *v1.GetCacheStatsRequest) (*v1.GetCacheStatsResponse, error) {
+	return &v1.GetCacheStatsResponse{
+		Stats: r.cache.stats.Snapshot(req.CacheNamespace, req.DbName, req.TableName),
+	}, nil
+}
+
+func (r *CacheAdminRepo) getNamespace(name string) (*CacheNamespace, error) {
+	if name == "" {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "cache_namespace required")
+	}
+	ns := r.cache.cache.GetNamespace(name, v1.RedisDB_UNSPECIFIED)
+	if ns == nil {
+		return nil, errors.NotFound(v1.ReasonCacheNamespaceNotFound, fmt.Sprintf("cache namespace '%s' not found", name))
+	}
+	return ns, nil
+}
+
+// 按行中的字段和值构建缓存 key，与查询时的 key 一致
+func (r *CacheAdminRepo) buildCacheKey(ns *CacheNamespace, table *v1.TableSchema, key *v1.Row) (string, error) {
+	if table == nil || table.DbName == "" || table.TableName == "" {
+		return "", errors.BadRequest(v1.ReasonInvalidArgument, "table db_name and table_name required")
+	}
+	if key == nil || len(key.Fields) == 0 {
+		return "", errors.BadRequest(v1.ReasonInvalidArgument, "key fields required")
+	}
+	fields := sortedRowFields(key)
+	values, ok := rowCacheValues(key, fields)
+	if !ok {
+		return "", errors.BadRequest(v1.ReasonInvalidArgument, "key values must be non-null scalars")
+	}
+	return r.cache.buildCacheKey(ns, table, fields, values), nil
+}
+
+func sortedRowFields(row *v1.Row) []string {
+	fields := make([]string, 0, len(row.Fields))
+	for field := range row.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// SCAN 匹配的 key 并逐批删除，避免使用 KEYS 或 FLUSHDB
+func scanDeleteKeys(ctx context.Context, client redis.Cmdable, pattern string) (int64, error) {
+	var (
+		cursor  uint64
+		deleted int64
+	)
+	for {
+		keys, next, err := client.Scan(ctx, cursor, pattern, flushScanCount).Result()
+		if err != nil {
+			return deleted, err
+		}
+		if len(keys) > 0 {
+			cmds, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+				for _, key := range keys {
+					pipe.Unlink(ctx, key)
+				}
+				return nil
+			})
+			if err != nil {
+				return deleted, err
+			}
+			for _, cmd := range cmds {
+				deleted += cmd.(*redis.IntCmd).Val()
+			}
+		}
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
+
+// 转义 redis glob 模式中的特殊字符
+func escapeRedisPattern(s string) string {
+	var sb strings.Builder
+	for _, c := range s {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
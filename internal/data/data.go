@@ -1,148 +1,346 @@
 package data
 
 import (
-	"datahub/api/datalayer/v1"
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/biz"
 	"datahub/internal/conf"
-	"io"
+	zaplog "datahub/internal/log"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-redis/redis"
 	"github.com/google/uuid"
 	"github.com/google/wire"
-	"gorm.io/driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"gorm.io/gorm"
 
-	stdLog "log"
-
 	gormLogger "gorm.io/gorm/logger"
 )
 
 var ProviderSet = wire.NewSet(
 	NewData,
+	NewSlowQueryLog,
 	NewDatabase,
+	NewReplicas,
 	NewRedisClients,
+	NewLocalCache,
+	NewCacheStats,
+	NewCacheCodec,
+	NewIdempotencyStore,
 	NewDatalayerRepo,
 	NewCachingDatalayerRepo,
+	wire.Bind(new(biz.DatalayerRepo), new(*CachingDatalayerRepo)),
+	NewCacheAdminRepo,
+	NewDatabaseAdminRepo,
+	wire.Bind(new(biz.DatabaseRegistry), new(*Data)),
+	NewBinlogInvalidator,
+	NewHealthChecker,
+	wire.Bind(new(healthpb.HealthServer), new(*HealthChecker)),
 )
 
 type Data struct {
 	db           map[string]*gorm.DB
+	replicas     map[string]*ReplicaSet         // 键是数据库名称，只包含配置了副本的数据库
+	sources      map[string]*conf.Data_Database // 当前生效的数据库配置，用于热更新时比较
+	guards       map[string]*databaseGuard      // 每个数据库的并发名额和熔断器
+	dbMu         sync.RWMutex                   // 用于保护 db、replicas、sources 和 guards 的读写锁
 	cache        *RedisClient
-	transactions map[string]*gorm.DB // 存储活跃的事务，键是事务ID，值是事务对象
-	txMu         sync.RWMutex        // 用于保护 transactions map 的读写锁
+	transactions map[string]*openTransaction // 存储活跃的事务，键是事务ID
+	txMu         sync.RWMutex                // 用于保护 transactions map 的读写锁
+	draining     atomic.Bool                 // 服务停止中，不再接受新的事务
+	gracePeriod  time.Duration               // 停止时等待事务结束的最长时间
+	tables       sync.Map                    // 键是数据库名称，值是 *tableList
+	refs         sync.Map                    // 键是 *gorm.DB，值是 *dbRefs
+	drainHooks   []func()
+	drainOnce    sync.Once
+	log          *log.Helper
+}
+
+type openTransaction struct {
+	tx          *gorm.DB
+	dbName      string
+	startedAt   time.Time
+	afterCommit []func(context.Context) // 提交后执行，如清除事务中写入的行的缓存
+	release     func()                  // 释放事务所在连接池的引用
 }
 
-type RedisClient struct {
-	clients map[int32]*redis.Client
+// dbRefs 记录取出连接池后还没有用完的请求和事务，热更新替换下来的连接池在引用全部释放后才关闭
+type dbRefs struct {
+	n        atomic.Int64
+	released chan struct{}
 }
 
 type ormLogger struct {
 	*log.Helper
+	level log.Level
 }
 
 func (o *ormLogger) Printf(format string, args ...interface{}) {
-	o.Debugf(format, args...)
+	o.Log(o.level, "msg", fmt.Sprintf(format, args...))
+}
+
+// sqlDebugLogger 在 debug 级别输出所有 SQL，关闭时跳过 SQL 的格式化；出错的 SQL 始终以 error 级别输出
+type sqlDebugLogger struct {
+	gormLogger.Interface
+	errors gormLogger.Interface
+	levels *zaplog.Levels
+	name   string
+}
+
+func (s *sqlDebugLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.errors.Trace(ctx, begin, fc, err)
+		return
+	}
+	if s.levels != nil && !s.levels.Enabled(s.name, log.LevelDebug) {
+		return
+	}
+	s.Interface.Trace(ctx, begin, fc, err)
 }
 
-func NewData(c *conf.Data, logger log.Logger, dbs map[string]*gorm.DB, cache *RedisClient) (*Data, func(), error) {
-	d := &Data{db: dbs, cache: cache, transactions: make(map[string]*gorm.DB)}
+func NewData(c *conf.Data, cfg config.Config, levels *zaplog.Levels, logger log.Logger, dbs map[string]*gorm.DB, replicas map[string]*ReplicaSet, cache *RedisClient, slow *SlowQueryLog) (*Data, func(), error) {
+	d := &Data{
+		db:           dbs,
+		replicas:     replicas,
+		sources:      make(map[string]*conf.Data_Database, len(c.Databases)),
+		guards:       make(map[string]*databaseGuard, len(c.Databases)),
+		cache:        cache,
+		transactions: make(map[string]*openTransaction),
+		gracePeriod:  c.TransactionGracePeriod.AsDuration(),
+		log:          log.NewHelper(logger),
+	}
+	if d.gracePeriod <= 0 {
+		d.gracePeriod = defaultTransactionGracePeriod
+	}
+	for _, source := range c.Databases {
+		d.sources[source.Name] = source
+		d.guards[source.Name] = newDatabaseGuard(source, logger)
+	}
+
+	if err := prometheus.Register(&dataCollector{d: d}); err != nil {
+		return nil, nil, err
+	}
+
+	reloader := newDatabaseReloader(d, c, levels, slow, logger)
+	if cfg != nil {
+		if err := cfg.Watch("data", reloader.onChange); err != nil {
+			log.NewHelper(logger).Errorf("watch data config error: %v", err)
+			return nil, nil, err
+		}
+	}
+
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		reloader.close()
+
+		// 先回滚未完成的事务，再关闭连接池
+		d.draining.Store(true)
+		d.rollbackTransactions()
 
 		//关闭数据库连接
+		d.dbMu.Lock()
 		for _, db := range d.db {
 			sql, _ := db.DB()
 			_ = sql.Close()
 		}
-
-		//关闭redis连接
-		for _, client := range cache.clients {
-			_ = client.Close()
+		for _, set := range d.replicas {
+			set.Close()
 		}
+		d.dbMu.Unlock()
 
-		// 清理未完成的事务
-		d.txMu.Lock()
-		if len(d.transactions) > 0 {
-			log.NewHelper(logger).Warnf("found %d unfinished transactions during cleanup. Attempting rollback.", len(d.transactions))
-			for id, tx := range d.transactions {
-				log.NewHelper(logger).Infof("rolling back transaction %s", id)
-				_ = tx.Rollback()
-			}
-			d.transactions = make(map[string]*gorm.DB)
-		}
-		d.txMu.Unlock()
+		//关闭redis连接
+		cache.Close()
 	}
 	return d, cleanup, nil
 }
 
-func NewDatabase(c *conf.Data, l *conf.Log, logger log.Logger) (map[string]*gorm.DB, error) {
+func NewDatabase(c *conf.Data, levels *zaplog.Levels, logger log.Logger, slow *SlowQueryLog) (map[string]*gorm.DB, error) {
 	dbs := make(map[string]*gorm.DB)
 	for _, source := range c.Databases {
-		db, err := gorm.Open(mysql.Open(source.Dsn), &gorm.Config{})
+		db, err := openDatabase(source, source.Dsn, rolePrimary, levels, logger, slow, &gorm.Config{})
 		if err != nil {
 			log.NewHelper(logger).Errorf("connect to dib error: %v", err)
 			return nil, err
 		}
-		if l.Level == "debug" {
-			db.Logger = gormLogger.New(&ormLogger{log.NewHelper(logger)}, gormLogger.Config{
-				SlowThreshold:             time.Second,
-				LogLevel:                  gormLogger.Info,
-				IgnoreRecordNotFoundError: false,
-				Colorful:                  false,
-			})
-		}
 		dbs[source.Name] = db
 	}
 
 	return dbs, nil
 }
 
-func NewRedisClients(c *conf.Data, l *conf.Log, logger log.Logger) (*RedisClient, error) {
-	if l.Level != "debug" {
-		redis.SetLogger(stdLog.New(io.Discard, "", 0))
+// 打开主库或副本，dsn 为主库或副本的连接串，连接池和超时使用 source 中的配置，
+// role 用于 SQL 指标、链路追踪和慢查询日志，slow 为 nil 时不记录慢查询
+func openDatabase(source *conf.Data_Database, dsn, role string, levels *zaplog.Levels, logger log.Logger, slow *SlowQueryLog, cfg *gorm.Config) (*gorm.DB, error) {
+	dsn, err := applyDSNTimeouts(source, dsn)
+	if err != nil {
+		return nil, err
+	}
+	dialector, err := openDialector(source.Driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, cfg)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	configurePool(sqlDB, source)
+	if err := db.Use(&sqlMetrics{db: source.Name, role: role}); err != nil {
+		return nil, err
+	}
+	if err := db.Use(&sqlTracing{db: source.Name, role: role}); err != nil {
+		return nil, err
+	}
+	if slow != nil {
+		if err := db.Use(slow.plugin(source.Name, role)); err != nil {
+			return nil, err
+		}
 	}
-	rdb := &RedisClient{
-		clients: make(map[int32]*redis.Client),
+	// SQL 日志的 logger 名称为 sql/<数据库名>，可以单独打开某个数据库的 debug 日志；慢查询由 SlowQueryLog 单独记录
+	name := "sql/" + source.Name
+	helper := log.NewHelper(log.With(logger, zaplog.LoggerKey, name))
+	db.Logger = &sqlDebugLogger{
+		Interface: gormLogger.New(&ormLogger{helper, log.LevelDebug}, gormLogger.Config{
+			LogLevel:                  gormLogger.Info,
+			IgnoreRecordNotFoundError: false,
+			Colorful:                  false,
+		}),
+		errors: gormLogger.New(&ormLogger{helper, log.LevelError}, gormLogger.Config{
+			LogLevel: gormLogger.Error,
+			Colorful: false,
+		}),
+		levels: levels,
+		name:   name,
 	}
+	return db, nil
+}
 
-	// 初始化所有db的客户端
-	for dbNum := range v1.RedisDB_name {
-		if dbNum != int32(v1.RedisDB_UNSPECIFIED) {
-			client := redis.NewFailoverClient(&redis.FailoverOptions{
-				MasterName:    c.Redis.Master,
-				SentinelAddrs: c.Redis.SentinelAddrs,
-				Password:      c.Redis.Password,
-				DB:            int(dbNum - 1), //redis的db从0开始
-			})
+// database 返回数据库的主库连接，未配置时返回 NotFound
+// database 返回数据库的主库，用完后必须调用返回的 release
+func (d *Data) database(name string) (*gorm.DB, func(), error) {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+	db, ok := d.db[name]
+	if !ok {
+		return nil, nil, errDatabaseNotFound(name)
+	}
+	return db, d.hold(db), nil
+}
 
-			if _, err := client.Ping().Result(); err != nil {
-				log.NewHelper(logger).Errorf("connect to redis %d error: %v", dbNum, err)
-				return nil, err
+func errDatabaseNotFound(name string) error {
+	return errors.NotFound(v1.ReasonDatabaseNotFound, fmt.Sprintf("database '%s' not found", name))
+}
+
+// hold 增加连接池的引用，返回的函数释放引用，可以多次调用。
+// 调用方需持有 dbMu 读锁，保证连接池在取出时还没有被热更新移除
+func (d *Data) hold(db *gorm.DB) func() {
+	v, _ := d.refs.LoadOrStore(db, &dbRefs{released: make(chan struct{}, 1)})
+	refs := v.(*dbRefs)
+	refs.n.Add(1)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if refs.n.Add(-1) == 0 {
+				select {
+				case refs.released <- struct{}{}:
+				default:
+				}
 			}
+		})
+	}
+}
 
-			rdb.clients[dbNum] = client
+// waitReleased 等待已经移除的连接池的引用全部释放，返回 ctx 结束时剩余的引用数
+func (d *Data) waitReleased(ctx context.Context, db *gorm.DB) int64 {
+	v, ok := d.refs.LoadAndDelete(db)
+	if !ok {
+		return 0
+	}
+	refs := v.(*dbRefs)
+	for {
+		n := refs.n.Load()
+		if n <= 0 {
+			return 0
+		}
+		select {
+		case <-refs.released:
+		case <-ctx.Done():
+			return n
 		}
 	}
+}
 
-	return rdb, nil
+// HasDatabase 判断数据库是否已配置
+func (d *Data) HasDatabase(name string) bool {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+	_, ok := d.sources[name]
+	return ok
 }
 
-func (r *RedisClient) GetRedis(num int32) *redis.Client {
-	return r.clients[num]
+// Databases 返回当前配置的数据库，按名称排序
+func (d *Data) Databases() []*v1.DatabaseInfo {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+
+	databases := make([]*v1.DatabaseInfo, 0, len(d.db))
+	for name := range d.db {
+		info := &v1.DatabaseInfo{Name: name}
+		if source := d.sources[name]; source != nil {
+			info.Driver = strings.ToLower(source.Driver)
+			if info.Driver == "" {
+				info.Driver = driverMySQL
+			}
+			info.ReplicaCount = int32(len(source.ReplicaDsns))
+		}
+		databases = append(databases, info)
+	}
+	sort.Slice(databases, func(i, j int) bool { return databases[i].Name < databases[j].Name })
+	return databases
+}
+
+func (d *Data) replicaSet(name string) *ReplicaSet {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+	return d.replicas[name]
 }
 
 func (d *Data) BeginTransaction(dbName string) (string, *gorm.DB, error) {
-	tx := d.db[dbName].Begin()
+	if d.draining.Load() {
+		return "", nil, errShuttingDown()
+	}
+	db, release, err := d.database(dbName)
+	if err != nil {
+		return "", nil, err
+	}
+	tx := db.Begin()
 	if tx.Error != nil {
+		release()
 		return "", nil, tx.Error
 	}
 
 	txID := uuid.NewString()
 
 	d.txMu.Lock()
-	d.transactions[txID] = tx
+	if d.draining.Load() {
+		// 开始事务期间服务进入停止流程
+		d.txMu.Unlock()
+		tx.Rollback()
+		release()
+		return "", nil, errShuttingDown()
+	}
+	d.transactions[txID] = &openTransaction{tx: tx, dbName: dbName, startedAt: time.Now(), release: release}
 	d.txMu.Unlock()
 
 	return txID, tx, nil
@@ -155,8 +353,33 @@ func (d *Data) GetTransaction(transactionId string) (*gorm.DB, bool) {
 	d.txMu.RLock()
 	defer d.txMu.RUnlock()
 
-	tx, ok := d.transactions[transactionId]
-	return tx, ok
+	t, ok := d.transactions[transactionId]
+	if !ok {
+		return nil, false
+	}
+	return t.tx, true
+}
+
+// AfterCommit 注册事务提交后执行的回调，事务不存在时返回 false
+func (d *Data) AfterCommit(transactionId string, fn func(context.Context)) bool {
+	d.txMu.Lock()
+	defer d.txMu.Unlock()
+	t, ok := d.transactions[transactionId]
+	if !ok {
+		return false
+	}
+	t.afterCommit = append(t.afterCommit, fn)
+	return true
+}
+
+// commitHooks 返回事务注册的提交回调
+func (d *Data) commitHooks(transactionId string) []func(context.Context) {
+	d.txMu.RLock()
+	defer d.txMu.RUnlock()
+	if t, ok := d.transactions[transactionId]; ok {
+		return t.afterCommit
+	}
+	return nil
 }
 
 func (d *Data) RemoveTransaction(transactionId string) {
@@ -164,6 +387,10 @@ func (d *Data) RemoveTransaction(transactionId string) {
 		return
 	}
 	d.txMu.Lock()
-	defer d.txMu.Unlock()
+	t, ok := d.transactions[transactionId]
 	delete(d.transactions, transactionId)
+	d.txMu.Unlock()
+	if ok {
+		t.release()
+	}
 }
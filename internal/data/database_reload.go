@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"datahub/internal/conf"
+	zaplog "datahub/internal/log"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const defaultDrainTimeout = 5 * time.Minute
+
+// databaseReloader 监听配置变化，打开新增的数据库，排空并关闭移除或连接参数变化的数据库。
+// 旧连接池上进行中的事务和查询不受影响，全部结束或超时后才关闭
+type databaseReloader struct {
+	d            *Data
+	levels       *zaplog.Levels
+	slow         *SlowQueryLog
+	drainTimeout time.Duration
+	logger       log.Logger
+	log          *log.Helper
+
+	mu     sync.Mutex     // 串行执行热更新
+	wg     sync.WaitGroup // 正在排空的旧连接池
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+type openedDatabase struct {
+	source   *conf.Data_Database
+	db       *gorm.DB
+	replicas *ReplicaSet
+}
+
+func newDatabaseReloader(d *Data, c *conf.Data, levels *zaplog.Levels, slow *SlowQueryLog, logger log.Logger) *databaseReloader {
+	r := &databaseReloader{
+		d:            d,
+		levels:       levels,
+		slow:         slow,
+		drainTimeout: c.DrainTimeout.AsDuration(),
+		logger:       logger,
+		log:          log.NewHelper(logger),
+	}
+	if r.drainTimeout <= 0 {
+		r.drainTimeout = defaultDrainTimeout
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r
+}
+
+func (r *databaseReloader) onChange(_ string, v config.Value) {
+	var c conf.Data
+	if err := v.Scan(&c); err != nil {
+		r.log.Errorf("scan data config on change error: %v", err)
+		return
+	}
+	r.reload(c.Databases)
+}
+
+func (r *databaseReloader) reload(databases []*conf.Data_Database) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next := make(map[string]*conf.Data_Database, len(databases))
+	for _, source := range databases {
+		if source.Name == "" {
+			r.log.Errorf("reload databases aborted: database name is required")
+			return
+		}
+		if _, ok := next[source.Name]; ok {
+			r.log.Errorf("reload databases aborted: duplicate database %s", source.Name)
+			return
+		}
+		next[source.Name] = source
+	}
+
+	r.d.dbMu.RLock()
+	current := make(map[string]*conf.Data_Database, len(r.d.sources))
+	for name, source := range r.d.sources {
+		current[name] = source
+	}
+	r.d.dbMu.RUnlock()
+
+	// 先在锁外打开新的连接，失败时保留旧连接
+	var (
+		opened      []*openedDatabase
+		poolChanged []*conf.Data_Database
+	)
+	for name, source := range next {
+		old, ok := current[name]
+		if ok && sameConnection(old, source) {
+			if !proto.Equal(old, source) {
+				poolChanged = append(poolChanged, source)
+			}
+			continue
+		}
+		db, err := openDatabase(source, source.Dsn, rolePrimary, r.levels, r.logger, r.slow, &gorm.Config{})
+		if err != nil {
+			r.log.Errorf("reload databases: open database %s error: %v", name, err)
+			continue
+		}
+		var replicas *ReplicaSet
+		if len(source.ReplicaDsns) > 0 {
+			if replicas, err = newReplicaSet(source, r.levels, r.logger, r.slow); err != nil {
+				closeDB(db)
+				continue
+			}
+		}
+		opened = append(opened, &openedDatabase{source: source, db: db, replicas: replicas})
+	}
+
+	var retired []*openedDatabase
+	r.d.dbMu.Lock()
+	for _, o := range opened {
+		name := o.source.Name
+		if old, ok := r.d.db[name]; ok {
+			retired = append(retired, &openedDatabase{source: r.d.sources[name], db: old, replicas: r.d.replicas[name]})
+		}
+		r.d.db[name] = o.db
+		r.d.sources[name] = o.source
+		if g, ok := r.d.guards[name]; ok {
+			g.update(o.source)
+			g.resetBreaker()
+		} else {
+			r.d.guards[name] = newDatabaseGuard(o.source, r.logger)
+		}
+		if o.replicas != nil {
+			r.d.replicas[name] = o.replicas
+		} else {
+			delete(r.d.replicas, name)
+		}
+	}
+	for name := range current {
+		if _, ok := next[name]; ok {
+			continue
+		}
+		retired = append(retired, &openedDatabase{source: r.d.sources[name], db: r.d.db[name], replicas: r.d.replicas[name]})
+		delete(r.d.db, name)
+		delete(r.d.replicas, name)
+		delete(r.d.sources, name)
+		delete(r.d.guards, name)
+	}
+	for _, source := range poolChanged {
+		r.d.sources[source.Name] = source
+		r.d.guards[source.Name].update(source)
+		applyPool(r.d.db[source.Name], source)
+		if set := r.d.replicas[source.Name]; set != nil {
+			for _, rep := range set.replicas {
+				applyPool(rep.db, source)
+			}
+		}
+	}
+	r.d.dbMu.Unlock()
+
+	for _, o := range opened {
+		r.log.Infof("database %s opened by config reload", o.source.Name)
+	}
+	for _, source := range poolChanged {
+		r.log.Infof("connection pool and limits of database %s updated by config reload", source.Name)
+	}
+	for _, o := range retired {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.drain(o)
+		}()
+	}
+}
+
+// drain 等待旧连接池（包括副本）上的请求和事务全部释放引用后关闭，超时后强制关闭
+func (r *databaseReloader) drain(o *openedDatabase) {
+	name := o.source.Name
+	r.log.Infof("draining database %s", name)
+
+	// 服务退出时不再等待
+	ctx, cancel := context.WithTimeout(r.ctx, r.drainTimeout)
+	defer cancel()
+	if o.replicas != nil {
+		for _, rep := range o.replicas.replicas {
+			r.d.waitReleased(ctx, rep.db)
+		}
+	}
+	o.replicas.Close()
+
+	if n := r.d.waitReleased(ctx, o.db); n > 0 {
+		r.log.Warnf("drain database %s timed out after %s with %d requests or transactions in use, closing", name, r.drainTimeout, n)
+	}
+	closeDB(o.db)
+	r.log.Infof("database %s closed", name)
+}
+func (r *databaseReloader) close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// 除连接池、并发上限和熔断参数外的配置都相同时，可以复用已有连接
+func sameConnection(a, b *conf.Data_Database) bool {
+	a, b = proto.Clone(a).(*conf.Data_Database), proto.Clone(b).(*conf.Data_Database)
+	for _, c := range []*conf.Data_Database{a, b} {
+		c.MaxOpenConns, c.MaxIdleConns = 0, 0
+		c.ConnMaxLifetime, c.ConnMaxIdleTime = nil, nil
+		c.MaxConcurrentRequests, c.MaxQueueWait, c.CircuitBreaker = 0, nil, nil
+	}
+	return proto.Equal(a, b)
+}
+
+func applyPool(db *gorm.DB, source *conf.Data_Database) {
+	if sqlDB, err := db.DB(); err == nil {
+		configurePool(sqlDB, source)
+	}
+}
+
+func closeDB(db *gorm.DB) {
+	if sqlDB, err := db.DB(); err == nil {
+		_ = sqlDB.Close()
+	}
+}
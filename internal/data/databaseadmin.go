@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type DatabaseAdminRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDatabaseAdminRepo(data *Data, logger log.Logger) biz.DatabaseAdminRepo {
+	return &DatabaseAdminRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+var _ biz.DatabaseAdminRepo = (*DatabaseAdminRepo)(nil)
+
+func (r *DatabaseAdminRepo) GetPoolStats(ctx context.Context, req *v1.GetPoolStatsRequest) (*v1.GetPoolStatsResponse, error) {
+	if req.DbName != "" {
+		if !r.data.HasDatabase(req.DbName) {
+			return nil, errDatabaseNotFound(req.DbName)
+		}
+	}
+	return &v1.GetPoolStatsResponse{Stats: r.data.PoolStats(req.DbName)}, nil
+}
@@ -4,8 +4,7 @@ import (
 	"context"
 	v1 "datahub/api/datalayer/v1"
 	"datahub/internal/biz"
-	"datahub/pkg/global"
-	"datahub/pkg/md"
+	"datahub/internal/conf"
 	"fmt"
 	"strings"
 	"time"
@@ -19,20 +18,31 @@ import (
 )
 
 type DatalayerRepo struct {
-	data *Data
-	log  *log.Helper
+	data        *Data
+	retry       *RetryOptions
+	idempotency *IdempotencyStore
+	log         *log.Helper
 }
 
-func NewDatalayerRepo(data *Data, logger log.Logger) *DatalayerRepo {
+func NewDatalayerRepo(data *Data, c *conf.Data, idempotency *IdempotencyStore, logger log.Logger) *DatalayerRepo {
+	helper := log.NewHelper(logger)
 	return &DatalayerRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:        data,
+		retry:       newRetryOptions(c.Retry, helper),
+		idempotency: idempotency,
+		log:         helper,
 	}
 }
 
 var _ biz.DatalayerRepo = (*DatalayerRepo)(nil)
 
 func (r *DatalayerRepo) Query(ctx context.Context, req *v1.QueryRequest) (*v1.QueryResponse, error) {
+	if req.Table == nil {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
+	}
+	if !r.data.HasDatabase(req.Table.DbName) {
+		return nil, errDatabaseNotFound(req.Table.DbName)
+	}
 	return &v1.QueryResponse{}, nil
 }
 
@@ -142,7 +152,12 @@ func (r *DatalayerRepo) buildSubQuery(ctx context.Context, req *v1.QueryRequest)
 		return nil, fmt.Errorf("subquery DbName required")
 	}
 
-	db := r.data.db[req.Table.DbName].WithContext(ctx)
+	db, _, release, err := r.data.readDB(req.Table.DbName, req.Consistency)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	db = db.WithContext(ctx)
 	if req.TransactionId != "" {
 		tx, ok := r.data.GetTransaction(req.TransactionId)
 		if !ok {
@@ -157,7 +172,7 @@ func (r *DatalayerRepo) buildSubQuery(ctx context.Context, req *v1.QueryRequest)
 	selectClauses := make([]string, 0, len(req.SelectFields))
 	if len(req.SelectFields) > 0 {
 		for _, sf := range req.SelectFields {
-			selectClauses = append(selectClauses, r.data.db[req.Table.DbName].NamingStrategy.ColumnName("", sf))
+			selectClauses = append(selectClauses, db.NamingStrategy.ColumnName("", sf))
 		}
 	}
 	for _, agg := range req.Aggregations {
@@ -181,7 +196,7 @@ func (r *DatalayerRepo) buildSubQuery(ctx context.Context, req *v1.QueryRequest)
 
 	// 2. 构建 Where 子句
 	for _, join := range req.Joins {
-		joinStr, err := buildJoinClause(req.Table.TableName, join)
+		joinStr, err := buildJoinClause(db, req.Table.TableName, join)
 		if err != nil {
 			return nil, fmt.Errorf("subquery join error: %w", err)
 		}
@@ -203,7 +218,7 @@ func (r *DatalayerRepo) buildSubQuery(ctx context.Context, req *v1.QueryRequest)
 	if req.GroupBy != nil && len(req.GroupBy.Fields) > 0 {
 		quotedGroupByFields := make([]string, len(req.GroupBy.Fields))
 		for i, f := range req.GroupBy.Fields {
-			quotedGroupByFields[i] = r.data.db[req.Table.DbName].NamingStrategy.ColumnName("", f)
+			quotedGroupByFields[i] = db.NamingStrategy.ColumnName("", f)
 		}
 		db = db.Group(strings.Join(quotedGroupByFields, ", "))
 	}
@@ -236,7 +251,7 @@ func mapToProtoRow(ctx context.Context, record map[string]any) *v1.Row {
 			// 对于其他标准类型，使用通用的转换
 			protoVal, err = structpb.NewValue(v)
 			if err != nil {
-				log.Errorf("traceId: %s failed to convert value for key '%s' (Go type: %T, value: %v) to Protobuf Value: %v", md.GetMetadata(ctx, global.RequestIdMd), key, val, val, err)
+				log.Context(ctx).Errorf("failed to convert value for key '%s' (Go type: %T, value: %v) to Protobuf Value: %v", key, val, val, err)
 			}
 		}
 		fields[key] = protoVal
@@ -351,8 +366,8 @@ func buildAggregationClause(agg *v1.Aggregation) (string, error) {
 	return fmt.Sprintf("%s(%s) AS %s", funcName, safeField, safeAlias), nil
 }
 
-// 构建 GORM Joins 字符串
-func buildJoinClause(primaryTable string, join *v1.Join) (string, error) {
+// 构建 GORM Joins 字符串，表名和字段按数据库方言加引号
+func buildJoinClause(db *gorm.DB, primaryTable string, join *v1.Join) (string, error) {
 	if join.TargetTable == "" {
 		return "", fmt.Errorf("join target_table is required")
 	}
@@ -386,24 +401,24 @@ func buildJoinClause(primaryTable string, join *v1.Join) (string, error) {
 			return "", fmt.Errorf("only EQ operator is typically supported in JOIN ON conditions, got: %s", cond.Operator)
 		}
 
-		quotedPrimaryTable := "`" + primaryTable + "`"
-		quotedTargetTable := "`" + join.TargetTable + "`"
-
-		quotedPrimaryField := "`" + cond.FieldFromPrimaryTable + "`"
-		quotedJoinedField := "`" + cond.FieldFromJoinedTable + "`"
-
 		// 拼接成 table.field 格式
-		qualifiedPrimaryField := fmt.Sprintf("%s.%s", quotedPrimaryTable, quotedPrimaryField)
-		qualifiedJoinedField := fmt.Sprintf("%s.%s", quotedTargetTable, quotedJoinedField)
+		qualifiedPrimaryField := db.Statement.Quote(clause.Column{Table: primaryTable, Name: cond.FieldFromPrimaryTable})
+		qualifiedJoinedField := db.Statement.Quote(clause.Column{Table: join.TargetTable, Name: cond.FieldFromJoinedTable})
 
 		onConditionStrings = append(onConditionStrings, fmt.Sprintf("%s %s %s", qualifiedPrimaryField, opStr, qualifiedJoinedField))
 	}
 
 	// 格式: JOIN_TYPE target_table ON (condition1 AND condition2 ...)
-	return fmt.Sprintf("%s %s ON %s", joinTypeStr, join.TargetTable, strings.Join(onConditionStrings, " AND ")), nil
+	return fmt.Sprintf("%s %s ON %s", joinTypeStr, db.Statement.Quote(clause.Table{Name: join.TargetTable}), strings.Join(onConditionStrings, " AND ")), nil
 }
 
 func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.MutationResponse, error) {
+	return r.idempotency.Do(ctx, "insert", req.GetTable().GetDbName(), mutationIdempotencyKey(req.TransactionId, req.IdempotencyKey), req, func() (*v1.MutationResponse, error) {
+		return r.insert(ctx, req)
+	})
+}
+
+func (r *DatalayerRepo) insert(ctx context.Context, req *v1.InsertRequest) (*v1.MutationResponse, error) {
 	if req.Table == nil {
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
 	}
@@ -411,24 +426,27 @@ func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "rows cannot be empty")
 	}
 
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-	r.log.Debugf("traceId: %s insert req: %+v", traceId, req)
+	r.log.WithContext(ctx).Debugf("insert req: %+v", req)
 
-	db := r.data.db[req.Table.DbName].WithContext(ctx)
+	db, release, err := r.data.database(req.Table.DbName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if req.TransactionId != "" {
 		tx, ok := r.data.GetTransaction(req.TransactionId)
 		if !ok {
 			return nil, errors.NotFound(v1.ReasonInvalidTransactionID, fmt.Sprintf("transaction %s not found or expired", req.TransactionId))
 		}
-		db = tx.WithContext(ctx) // 在事务中执行
-		r.log.Debugf("traceId: %s insert is executing within transaction: %s", traceId, req.TransactionId)
+		db = tx // 在事务中执行
+		r.log.WithContext(ctx).Debugf("insert is executing within transaction: %s", req.TransactionId)
 	}
 
 	// 1. 转换数据类型
 	recordsToInsert := make([]map[string]any, 0, len(req.Rows))
 	for i, protoRow := range req.Rows {
 		if protoRow == nil || len(protoRow.Fields) == 0 {
-			r.log.Warnf("traceId: %s skipping empty row at index %d during insert into table %s", traceId, i, req.Table)
+			r.log.WithContext(ctx).Warnf("skipping empty row at index %d during insert into table %s", i, req.Table)
 			continue
 		}
 
@@ -436,7 +454,7 @@ func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.
 		for key, protoVal := range protoRow.Fields {
 			goVal, err := protobufValueToAny(protoVal)
 			if err != nil {
-				r.log.Errorf("traceId: %s failed to convert value for key '%s' in row %d: %v", traceId, key, i, err)
+				r.log.WithContext(ctx).Errorf("failed to convert value for key '%s' in row %d: %v", key, i, err)
 				return nil, errors.BadRequest(v1.ReasonInvalidArgument, fmt.Sprintf("invalid value for field '%s': %v", key, err))
 			}
 			record[key] = goVal
@@ -445,17 +463,15 @@ func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.
 	}
 
 	if len(recordsToInsert) == 0 {
-		r.log.Warnf("traceId: %s no valid rows to insert into table %s after processing input.", traceId, req.Table)
+		r.log.WithContext(ctx).Warnf("no valid rows to insert into table %s after processing input.", req.Table)
 		return &v1.MutationResponse{AffectedRows: 0}, nil
 	}
 
-	// 2. 构建 GORM 操作
-	tx := db.Table(req.Table.TableName)
-
-	// 处理冲突策略
+	// 2. 处理冲突策略
+	var clauses []clause.Expression
 	switch req.OnConflict {
 	case v1.ConflictAction_IGNORE:
-		tx = tx.Clauses(clause.OnConflict{DoNothing: true})
+		clauses = append(clauses, clause.OnConflict{DoNothing: true})
 	case v1.ConflictAction_UPSERT:
 		if len(req.ConflictColumns) == 0 {
 			return nil, errors.BadRequest(v1.ReasonInvalidArgument, "conflict_columns field is required for UPSERT operation")
@@ -468,7 +484,7 @@ func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.
 			cols[i] = clause.Column{Name: col}
 		}
 		// 更新指定的列
-		tx = tx.Clauses(clause.OnConflict{
+		clauses = append(clauses, clause.OnConflict{
 			Columns:   cols,
 			DoUpdates: clause.AssignmentColumns(req.UpdateColumns),
 		})
@@ -478,24 +494,44 @@ func (r *DatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, fmt.Sprintf("unsupported conflict action: %s", req.OnConflict))
 	}
 
-	result := tx.Create(&recordsToInsert)
-	if result.Error != nil {
-		r.log.Errorf("traceId: %s insert failed to table %s: %v", traceId, req.Table, result.Error)
-		if strings.Contains(result.Error.Error(), "Duplicate") {
-			return nil, errors.Conflict(v1.ReasonDuplicate, result.Error.Error())
+	// 3. 执行插入
+	affected, err := r.mutate(ctx, req.Table.DbName, db, req.TransactionId == "" && req.IdempotencyKey != "", func(tx *gorm.DB) *gorm.DB {
+		return tx.Table(req.Table.TableName).Clauses(clauses...).Create(&recordsToInsert)
+	})
+	if isStatusError(err) {
+		return nil, err
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("insert failed to table %s: %v", req.Table, err)
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict(v1.ReasonDuplicate, err.Error())
 		} else {
-			return nil, errors.InternalServer(v1.ReasonInsertFailed, result.Error.Error())
+			return nil, errors.InternalServer(v1.ReasonInsertFailed, err.Error()).WithCause(err)
 		}
 	}
 
 	resp := &v1.MutationResponse{
-		AffectedRows: result.RowsAffected,
+		AffectedRows: affected,
+	}
+	// 驱动返回自增 ID 时 gorm 会写入每行的 @id；冲突时忽略或更新的行与 ID 对应不上，不返回
+	if req.OnConflict == v1.ConflictAction_FAIL || req.OnConflict == v1.ConflictAction_CONFLICT_ACTION_UNSPECIFIED {
+		for _, record := range recordsToInsert {
+			if id, ok := record["@id"].(int64); ok {
+				resp.GeneratedIds = append(resp.GeneratedIds, id)
+			}
+		}
 	}
 
 	return resp, nil
 }
 
 func (r *DatalayerRepo) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.MutationResponse, error) {
+	return r.idempotency.Do(ctx, "update", req.GetTable().GetDbName(), mutationIdempotencyKey(req.TransactionId, req.IdempotencyKey), req, func() (*v1.MutationResponse, error) {
+		return r.update(ctx, req)
+	})
+}
+
+func (r *DatalayerRepo) update(ctx context.Context, req *v1.UpdateRequest) (*v1.MutationResponse, error) {
 	if req.Table == nil {
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
 	}
@@ -506,18 +542,20 @@ func (r *DatalayerRepo) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "where clause is required for updates")
 	}
 
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-
-	r.log.Debugf("traceId: %s update req: Table=%s, Data=%v, Where=%v, TxID=%s", traceId, req.Table, req.Data, req.WhereClause, req.TransactionId)
+	r.log.WithContext(ctx).Debugf("update req: Table=%s, Data=%v, Where=%v, TxID=%s", req.Table, req.Data, req.WhereClause, req.TransactionId)
 
-	db := r.data.db[req.Table.DbName].WithContext(ctx)
+	db, release, err := r.data.database(req.Table.DbName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if req.TransactionId != "" {
 		tx, ok := r.data.GetTransaction(req.TransactionId)
 		if !ok {
 			return nil, errors.NotFound(v1.ReasonInvalidTransactionID, fmt.Sprintf("transaction %s not found or expired", req.TransactionId))
 		}
-		db = tx.WithContext(ctx) // 在事务中执行
-		r.log.Debugf("traceId: %s update is executing within transaction: %s", traceId, req.TransactionId)
+		db = tx // 在事务中执行
+		r.log.WithContext(ctx).Debugf("update is executing within transaction: %s", req.TransactionId)
 	}
 
 	// 1. 构造update map
@@ -525,44 +563,55 @@ func (r *DatalayerRepo) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.
 	for key, protoVal := range req.Data.Fields {
 		goVal, err := protobufValueToAny(protoVal)
 		if err != nil {
-			r.log.Errorf("traceId: %s failed to convert update value for key '%s': %v", traceId, key, err)
+			r.log.WithContext(ctx).Errorf("failed to convert update value for key '%s': %v", key, err)
 			return nil, errors.BadRequest(v1.ReasonInvalidArgument, fmt.Sprintf("invalid value for field '%s': %v", key, err))
 		}
 		updateData[key] = goVal
 	}
 
 	if len(updateData) == 0 {
-		r.log.Warnf("traceId: %s no valid update data provided after conversion", traceId)
+		r.log.WithContext(ctx).Warnf("no valid update data provided after conversion")
 		return &v1.MutationResponse{AffectedRows: 0}, nil
 	}
 
-	db = db.Table(req.Table.TableName)
-
 	// 2. 构建where子句
 	whereExpr, args, err := r.buildWhereConditions(ctx, req.WhereClause)
 	if err != nil {
 		return nil, errors.BadRequest("INVALID_WHERE_CLAUSE", err.Error())
 	}
 	if whereExpr == "" {
-		r.log.Warnf("traceId: %s update on table '%s' resulted in an empty effective WHERE clause!!!", traceId, req.Table)
-	} else {
-		db = db.Where(whereExpr, args...)
+		r.log.WithContext(ctx).Warnf("update on table '%s' resulted in an empty effective WHERE clause!!!", req.Table)
 	}
 
-	result := db.Updates(updateData)
-	if result.Error != nil {
-		r.log.Errorf("traceId: %s update failed for table %s: %v", traceId, req.Table, result.Error)
-		return nil, errors.InternalServer(v1.ReasonUpdateFailed, result.Error.Error())
+	affected, err := r.mutate(ctx, req.Table.DbName, db, req.TransactionId == "" && req.IdempotencyKey != "", func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Table(req.Table.TableName)
+		if whereExpr != "" {
+			tx = tx.Where(whereExpr, args...)
+		}
+		return tx.Updates(updateData)
+	})
+	if isStatusError(err) {
+		return nil, err
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("update failed for table %s: %v", req.Table, err)
+		return nil, errors.InternalServer(v1.ReasonUpdateFailed, err.Error()).WithCause(err)
 	}
 
 	resp := &v1.MutationResponse{
-		AffectedRows: result.RowsAffected,
+		AffectedRows: affected,
 	}
 
 	return resp, nil
 }
 
 func (r *DatalayerRepo) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.MutationResponse, error) {
+	return r.idempotency.Do(ctx, "delete", req.GetTable().GetDbName(), mutationIdempotencyKey(req.TransactionId, req.IdempotencyKey), req, func() (*v1.MutationResponse, error) {
+		return r.delete(ctx, req)
+	})
+}
+
+func (r *DatalayerRepo) delete(ctx context.Context, req *v1.DeleteRequest) (*v1.MutationResponse, error) {
 	if req.Table == nil {
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
 	}
@@ -570,101 +619,116 @@ func (r *DatalayerRepo) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "where clause is required for delete")
 	}
 
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-
-	r.log.Debugf("traceId: %s delete req: %+v", traceId, req)
+	r.log.WithContext(ctx).Debugf("delete req: %+v", req)
 
-	db := r.data.db[req.Table.DbName].WithContext(ctx)
+	db, release, err := r.data.database(req.Table.DbName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	if req.TransactionId != "" {
 		tx, ok := r.data.GetTransaction(req.TransactionId)
 		if !ok {
-			r.log.Warnf("traceId: %s delete failed: transaction %s not found or expired", traceId, req.TransactionId)
+			r.log.WithContext(ctx).Warnf("delete failed: transaction %s not found or expired", req.TransactionId)
 			return nil, errors.NotFound(v1.ReasonInvalidTransactionID, fmt.Sprintf("transaction %s not found or expired", req.TransactionId))
 		}
-		db = tx.WithContext(ctx) // 在事务中执行
-		r.log.Debugf("traceId: %s delete is executing within transaction: %s", traceId, req.TransactionId)
+		db = tx // 在事务中执行
+		r.log.WithContext(ctx).Debugf("delete is executing within transaction: %s", req.TransactionId)
 	}
 
 	// 1. 构建 WHERE 子句
 	whereExpr, args, err := r.buildWhereConditions(ctx, req.WhereClause)
 	if err != nil {
-		r.log.Errorf("traceId: %s failed to build where conditions for delete on table %s: %v", traceId, req.Table, err)
+		r.log.WithContext(ctx).Errorf("failed to build where conditions for delete on table %s: %v", req.Table, err)
 		return nil, errors.BadRequest(v1.ReasonInvalidWhereClause, err.Error())
 	}
 
 	if whereExpr == "" {
-		r.log.Errorf("traceId: %s delete on table '%s' aborted: effective WHERE clause is empty", traceId, req.Table)
+		r.log.WithContext(ctx).Errorf("delete on table '%s' aborted: effective WHERE clause is empty", req.Table)
 		return nil, errors.BadRequest(v1.ReasonInvalidWhereClause, "effective WHERE clause is empty")
 	}
 
-	result := db.Table(req.Table.TableName).Where(whereExpr, args...).Delete(nil)
-
-	if result.Error != nil {
-		r.log.Errorf("traceId: %s database delete failed for table %s: %v", traceId, req.Table, result.Error)
-		return nil, errors.InternalServer(v1.ReasonDeleteFailed, result.Error.Error())
+	affected, err := r.mutate(ctx, req.Table.DbName, db, req.TransactionId == "" && req.IdempotencyKey != "", func(tx *gorm.DB) *gorm.DB {
+		return tx.Table(req.Table.TableName).Where(whereExpr, args...).Delete(nil)
+	})
+	if isStatusError(err) {
+		return nil, err
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("database delete failed for table %s: %v", req.Table, err)
+		return nil, errors.InternalServer(v1.ReasonDeleteFailed, err.Error()).WithCause(err)
 	}
 
 	resp := &v1.MutationResponse{
-		AffectedRows: result.RowsAffected,
+		AffectedRows: affected,
 	}
 
 	return resp, nil
 }
 
 func (r *DatalayerRepo) BeginTransaction(ctx context.Context, req *v1.BeginTransactionRequest) (*v1.BeginTransactionResponse, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-	txID, _, err := r.data.BeginTransaction(req.DbName)
+	var txID string
+	err := r.data.guarded(ctx, req.DbName, func() (err error) {
+		txID, _, err = r.data.BeginTransaction(req.DbName)
+		return err
+	})
 	if err != nil {
-		r.log.Errorf("traceId: %s failed to begin transaction: %v", traceId, err)
+		r.log.WithContext(ctx).Errorf("failed to begin transaction: %v", err)
+		if isStatusError(err) {
+			return nil, err
+		}
 		return nil, errors.InternalServer(v1.ReasonTransactionError, fmt.Sprintf("failed to begin transaction: %v", err))
 	}
 
-	r.log.Infof("traceId: %s successfully started new transaction, id: %s", traceId, txID)
+	r.log.WithContext(ctx).Infof("successfully started new transaction, id: %s", txID)
 	return &v1.BeginTransactionResponse{
 		TransactionId: txID,
 	}, nil
 }
 
 func (r *DatalayerRepo) CommitTransaction(ctx context.Context, req *v1.TransactionRequest) (*emptypb.Empty, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
 	if req.TransactionId == "" {
-		r.log.Warnf("traceId: %s commit transaction failed: transaction_id cannot be empty", traceId)
+		r.log.WithContext(ctx).Warnf("commit transaction failed: transaction_id cannot be empty")
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "transaction_id is required")
 	}
 
-	r.log.Infof("traceId: %s commit transaction request for id: %s", req.TransactionId, traceId)
+	r.log.WithContext(ctx).Infof("commit transaction request for id: %s", req.TransactionId)
 
 	tx, ok := r.data.GetTransaction(req.TransactionId)
 	if !ok {
-		r.log.Warnf("traceId: %s commit transaction failed: transaction %s not found or expired", traceId, req.TransactionId)
+		r.log.WithContext(ctx).Warnf("commit transaction failed: transaction %s not found or expired", req.TransactionId)
 		return nil, errors.NotFound(v1.ReasonInvalidTransactionID, fmt.Sprintf("transaction %s not found or expired", req.TransactionId))
 	}
 
+	hooks := r.data.commitHooks(req.TransactionId)
 	err := tx.Commit().Error
 	// 无论成功或失败，都需要从 map 中移除事务记录
 	r.data.RemoveTransaction(req.TransactionId)
+	// 提交失败时结果不确定，同样执行回调；清除缓存不影响正确性
+	for _, hook := range hooks {
+		hook(context.WithoutCancel(ctx))
+	}
 
 	if err != nil {
-		r.log.Errorf("traceId: %s failed to commit transaction %s: %v", traceId, req.TransactionId, err)
+		r.log.WithContext(ctx).Errorf("failed to commit transaction %s: %v", req.TransactionId, err)
 		return nil, errors.InternalServer(v1.ReasonTransactionCommitFailed, fmt.Sprintf("failed to commit transaction %s: %v", req.TransactionId, err))
 	}
 
-	r.log.Infof("traceId: %s transaction %s committed successfully", traceId, req.TransactionId)
+	r.log.WithContext(ctx).Infof("transaction %s committed successfully", req.TransactionId)
 	return &emptypb.Empty{}, nil
 }
 
 func (r *DatalayerRepo) RollbackTransaction(ctx context.Context, req *v1.TransactionRequest) (*emptypb.Empty, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
 	if req.TransactionId == "" {
-		r.log.Warnf("traceId: %s rollback transaction failed: transaction_id cannot be empty", traceId)
+		r.log.WithContext(ctx).Warnf("rollback transaction failed: transaction_id cannot be empty")
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "transaction_id is required")
 	}
 
-	r.log.Infof("traceId: %s rollback transaction request for id: %s", traceId, req.TransactionId)
+	r.log.WithContext(ctx).Infof("rollback transaction request for id: %s", req.TransactionId)
 
 	tx, ok := r.data.GetTransaction(req.TransactionId)
 	if !ok {
-		r.log.Warnf("traceId: %s transaction %s not found or expired", traceId, req.TransactionId)
+		r.log.WithContext(ctx).Warnf("transaction %s not found or expired", req.TransactionId)
 		return nil, nil
 	}
 
@@ -673,17 +737,35 @@ func (r *DatalayerRepo) RollbackTransaction(ctx context.Context, req *v1.Transac
 	r.data.RemoveTransaction(req.TransactionId)
 
 	if err != nil {
-		r.log.Errorf("traceId: %s failed to rollback transaction %s: %v", req.TransactionId, traceId, err)
+		r.log.WithContext(ctx).Errorf("failed to rollback transaction %s: %v", req.TransactionId, err)
 		return nil, errors.InternalServer(v1.ReasonTransactionRollbackFailed, fmt.Sprintf("failed to rollback transaction %s: %v", req.TransactionId, err))
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func (r *DatalayerRepo) ListDatabases(ctx context.Context, req *v1.ListDatabasesRequest) (*v1.ListDatabasesResponse, error) {
+	return &v1.ListDatabasesResponse{Databases: r.data.Databases()}, nil
+}
+
 func (r *DatalayerRepo) ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ListTablesResponse, error) {
-	tables, err := r.data.db[req.DbName].Migrator().GetTables()
+	db, release, err := r.data.database(req.DbName)
 	if err != nil {
-		r.log.Warnf("traceId: %s list tables error: %v", md.GetMetadata(ctx, global.RequestIdMd), err)
+		return nil, err
+	}
+	defer release()
+	var tables []string
+	err = r.data.guarded(ctx, req.DbName, func() error {
+		return withRetry(ctx, db, func(tx *gorm.DB) (err error) {
+			tables, err = tx.Migrator().GetTables()
+			return err
+		}, r.retry)
+	})
+	if isStatusError(err) {
+		return nil, err
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("list tables error: %v", err)
 		return nil, errors.InternalServer(v1.ReasonListTablesFailed, err.Error())
 	}
 	return &v1.ListTablesResponse{TableNames: tables}, nil
@@ -694,13 +776,37 @@ func (r *DatalayerRepo) DescribeTable(ctx context.Context, req *v1.DescribeTable
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "table required")
 	}
 
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-
-	db := r.data.db[req.Table.DbName]
-	migrator := db.Migrator()
-
-	if !migrator.HasTable(req.Table.TableName) {
-		r.log.Warnf("traceId: %s table %s not found", traceId, req.Table.TableName)
+	// 1. 读取表结构，连接错误时整体重试
+	var (
+		exists      bool
+		columnTypes []gorm.ColumnType
+		indexes     []gorm.Index
+	)
+	err := r.retryRead(ctx, req.Table.DbName, req.Consistency, func(tx *gorm.DB) (err error) {
+		if exists, err = tableExists(tx, req.Table.TableName); err != nil {
+			return fmt.Errorf("check table: %w", err)
+		}
+		if !exists {
+			return nil
+		}
+		migrator := tx.Migrator()
+		if columnTypes, err = migrator.ColumnTypes(req.Table.TableName); err != nil {
+			return fmt.Errorf("get column types: %w", err)
+		}
+		if indexes, err = migrator.GetIndexes(req.Table.TableName); err != nil {
+			return fmt.Errorf("get indexes: %w", err)
+		}
+		return nil
+	})
+	if isStatusError(err) {
+		return nil, err
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to describe table %s: %v", req.Table.TableName, err)
+		return nil, errors.InternalServer(v1.ReasonDescribeTablesFailed, err.Error())
+	}
+	if !exists {
+		r.log.WithContext(ctx).Warnf("table %s not found", req.Table.TableName)
 		return nil, errors.NotFound(v1.ReasonDescribeTablesFailed, fmt.Sprintf("table '%s' not found", req.Table.TableName))
 	}
 
@@ -711,12 +817,6 @@ func (r *DatalayerRepo) DescribeTable(ctx context.Context, req *v1.DescribeTable
 	}
 
 	// 2. Get Column Information
-	columnTypes, err := migrator.ColumnTypes(req.Table.TableName)
-	if err != nil {
-		r.log.Errorf("traceId: %s failed to get column types for table %s: %v", traceId, req.Table.TableName, err)
-		return nil, errors.InternalServer(v1.ReasonDescribeTablesFailed, err.Error())
-	}
-
 	for _, colType := range columnTypes {
 		colMeta := &v1.ColumnMetadata{
 			Name:     colType.Name(),
@@ -739,12 +839,6 @@ func (r *DatalayerRepo) DescribeTable(ctx context.Context, req *v1.DescribeTable
 		resp.Columns = append(resp.Columns, colMeta)
 	}
 
-	indexes, err := migrator.GetIndexes(req.Table.TableName)
-	if err != nil {
-		r.log.Errorf("traceId: %s failed to get indexes for table %s: %v", traceId, req.Table.TableName, err)
-		return nil, errors.InternalServer(v1.ReasonDescribeTablesFailed, err.Error())
-	}
-
 	for _, index := range indexes {
 		idxMeta := &v1.IndexMetadata{
 			Name:      index.Name(),
@@ -762,7 +856,6 @@ func (r *DatalayerRepo) DescribeTable(ctx context.Context, req *v1.DescribeTable
 }
 
 func (r *DatalayerRepo) ExecRawSQL(ctx context.Context, req *v1.ExecRawSQLRequest) (*v1.ExecRawSQLResponse, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
 	if req.Db == "" {
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "db required")
 	}
@@ -770,21 +863,45 @@ func (r *DatalayerRepo) ExecRawSQL(ctx context.Context, req *v1.ExecRawSQLReques
 		return nil, errors.BadRequest(v1.ReasonInvalidArgument, "sql required")
 	}
 
-	db := r.data.db[req.Db].WithContext(ctx)
-	if req.TransactionId != "" {
+	db, release, err := r.data.database(req.Db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var affected int64
+	switch {
+	case req.TransactionId != "":
 		tx, ok := r.data.GetTransaction(req.TransactionId)
 		if !ok {
 			return nil, errors.NotFound(v1.ReasonInvalidTransactionID, fmt.Sprintf("transaction %s not found", req.TransactionId))
 		}
-		db = tx
-		r.log.Debugf("traceId:%s execute raw sql within transaction %s", traceId, req.TransactionId)
+		r.log.WithContext(ctx).Debugf("execute raw sql within transaction %s", req.TransactionId)
+		err = r.data.guarded(ctx, req.Db, func() error {
+			result := tx.Exec(req.Sql)
+			affected = result.RowsAffected
+			return result.Error
+		})
+	case req.Consistency == v1.Consistency_EVENTUAL && isReadOnlySQL(req.Sql):
+		// 客户端明确允许读副本时，只读语句发往副本，连接错误时重试
+		err = r.retryRead(ctx, req.Db, req.Consistency, func(tx *gorm.DB) error {
+			result := tx.Exec(req.Sql)
+			affected = result.RowsAffected
+			return result.Error
+		})
+	default:
+		// 原生写语句无法判断是否幂等，不重试
+		affected, err = r.mutate(ctx, req.Db, db, false, func(tx *gorm.DB) *gorm.DB {
+			return tx.Exec(req.Sql)
+		})
 	}
-
-	result := db.Exec(req.Sql)
-	if err := result.Error; err != nil {
-		r.log.Errorf("traceId: %s failed to execute raw sql: %v", traceId, err)
+	if err != nil {
+		if isStatusError(err) {
+			return nil, err
+		}
+		r.log.WithContext(ctx).Errorf("failed to execute raw sql: %v", err)
 		return nil, errors.InternalServer(v1.ReasonExecRawSqlFailed, err.Error())
 	}
 
-	return &v1.ExecRawSQLResponse{AffectedRows: result.RowsAffected}, nil
+	return &v1.ExecRawSQLResponse{AffectedRows: affected}, nil
 }
@@ -4,13 +4,15 @@ import (
 	"context"
 	v1 "datahub/api/datalayer/v1"
 	"datahub/internal/biz"
-	"datahub/pkg/global"
-	"datahub/pkg/md"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
-	"github.com/go-redis/redis"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -18,19 +20,26 @@ import (
 )
 
 const (
-	defaultCacheTTL = 4 * time.Hour
+	defaultCacheTTL      = 4 * time.Hour
+	defaultEmptyCacheTTL = time.Minute
 )
 
 type CachingDatalayerRepo struct {
 	wrapped *DatalayerRepo
 	cache   *RedisClient
+	local   *LocalCache
+	stats   *CacheStats
+	codec   *CacheCodec
 	log     *log.Helper
 }
 
-func NewCachingDatalayerRepo(wrapped *DatalayerRepo, cache *RedisClient, logger log.Logger) biz.DatalayerRepo {
+func NewCachingDatalayerRepo(wrapped *DatalayerRepo, cache *RedisClient, local *LocalCache, stats *CacheStats, codec *CacheCodec, logger log.Logger) *CachingDatalayerRepo {
 	return &CachingDatalayerRepo{
 		wrapped: wrapped,
 		cache:   cache,
+		local:   local,
+		stats:   stats,
+		codec:   codec,
 		log:     log.NewHelper(logger),
 	}
 }
@@ -39,165 +48,432 @@ var _ biz.DatalayerRepo = (*CachingDatalayerRepo)(nil)
 
 func (r *CachingDatalayerRepo) Query(ctx context.Context, req *v1.QueryRequest) (*v1.QueryResponse, error) {
 	// 不指定缓存字段或redis db，直接查数据库；select字段不为空时，直接查数据库，避免构建的缓存信息不齐全
-	if req.CacheByField == "" || req.RedisDb <= 0 || len(req.SelectFields) > 0 {
+	fields := normalizeCacheFields(req.CacheByField)
+	if len(fields) == 0 || (req.CacheNamespace == "" && req.RedisDb <= 0) || len(req.SelectFields) > 0 {
 		return r.wrapped.Query(ctx, req)
 	}
 
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-	// 验证 where 子句是否符合简单缓存模式： “field = value”
-	cacheable, value := r.isCacheableCondition(req.WhereClause, req.CacheByField)
+	cacheable, values := r.isCacheableCondition(req.WhereClause, fields)
 	if !cacheable {
-		r.log.Warnf("traceId: %s query condition does not match simple cache pattern for field %s, skip cache. req: %+v", traceId, req.CacheByField, req)
+		r.log.WithContext(ctx).Warnf("query condition does not match cache pattern for fields %v, skip cache. req: %+v", fields, req)
 		// 条件不匹配，查数据库
 		return r.wrapped.Query(ctx, req)
 	}
 
-	if value == nil {
-		r.log.Warnf("traceId: %s query value is nil for field %s, skip cache. req: %+v", traceId, req.CacheByField, req)
+	ns := r.getNamespace(ctx, req.CacheNamespace, req.RedisDb)
+	if ns == nil {
 		return r.wrapped.Query(ctx, req)
 	}
-
-	redisClient := r.cache.GetRedis(int32(req.RedisDb))
-	if redisClient == nil {
-		r.log.Warnf("traceId: %s failed to get redis client for db %s, skip cache. req: %+v", traceId, v1.RedisDB_name[int32(req.RedisDb)], req)
-		return r.wrapped.Query(ctx, req)
+	redisClient := ns.Client
+
+	cacheKey := r.buildCacheKey(ns, req.Table, fields, values)
+	localKey := r.buildLocalCacheKey(ns, cacheKey)
+	stats := r.stats.get(ns.Name, req.Table)
+
+	// --- 0. 先查进程内缓存 ---
+	if resp, ok := r.local.Get(localKey); ok {
+		stats.hits.Add(1)
+		stats.localHits.Add(1)
+		stats.bytesServed.Add(int64(proto.Size(resp)))
+		return resp, nil
 	}
 
-	cacheKey := r.buildCacheKey(req.Table, req.CacheByField, value)
-
-	// --- 1. 先查缓存 ---
-	cachedBytes, cacheErr := redisClient.Get(cacheKey).Bytes()
+	// --- 1. 再查 redis 缓存 ---
+	cachedBytes, cacheErr := redisClient.Get(ctx, cacheKey).Bytes()
 	if cacheErr == nil {
 		// 缓存命中
-		var response v1.QueryResponse
-		unmarshalErr := proto.Unmarshal(cachedBytes, &response)
-		if unmarshalErr != nil {
+		response, unmarshalErr := r.decodeCachedResponse(cachedBytes)
+		if errors.Is(unmarshalErr, errCachePayloadStale) {
+			// 旧格式或表结构已变更的条目，按未命中处理，查库后覆盖
+			r.log.WithContext(ctx).Debugf("stale cached data for key %s: %v", cacheKey, unmarshalErr)
+		} else if unmarshalErr != nil {
 			// 反序列化失败，不报错，继续查数据库
-			r.log.Errorf("traceId: %s failed to unmarshal cached data for key %s: %v", traceId, cacheKey, unmarshalErr)
+			stats.unmarshalFailures.Add(1)
+			r.log.WithContext(ctx).Errorf("failed to unmarshal cached data for key %s: %v", cacheKey, unmarshalErr)
 		} else {
-			// 反序列化成功，给缓存续期
-			redisClient.Expire(cacheKey, r.getCacheTTL(req))
-			return &response, nil
+			stats.hits.Add(1)
+			stats.bytesServed.Add(int64(len(cachedBytes)))
+			// 反序列化成功，给缓存续期；空结果保持较短的过期时间，不续期
+			if !isEmptyResponse(response) {
+				redisClient.Expire(ctx, cacheKey, r.getCacheTTL(ns, req))
+			}
+			r.local.Set(localKey, response)
+			return response, nil
 		}
 	} else if !errors.Is(cacheErr, redis.Nil) {
-		r.log.Errorf("traceId: %s error fetching from redis cache for key %s: %v. falling back to database.", traceId, cacheKey, cacheErr)
+		r.log.WithContext(ctx).Errorf("error fetching from redis cache for key %s: %v. falling back to database.", cacheKey, cacheErr)
 	}
 
 	// --- 2. 缓存未命中，查数据 ---
-	dbResp, dbErr := r.wrapped.Query(ctx, req)
+	stats.misses.Add(1)
+	// 回填缓存的数据从主库读取，避免把副本上尚未同步的旧数据写进缓存
+	fillReq := req
+	if req.Consistency != v1.Consistency_STRONG {
+		fillReq = proto.Clone(req).(*v1.QueryRequest)
+		fillReq.Consistency = v1.Consistency_STRONG
+	}
+	dbResp, dbErr := r.wrapped.Query(ctx, fillReq)
 	if dbErr != nil {
 		return dbResp, dbErr
 	}
 
-	// --- 3. 数据库命中，写回缓存 ---
-	dataToCache, marshalErr := proto.Marshal(dbResp)
+	// --- 3. 数据库命中，写回缓存；空结果只有显式开启负缓存时才写入 ---
+	ttl := r.getCacheTTL(ns, req)
+	if isEmptyResponse(dbResp) {
+		if !req.CacheEmpty {
+			return dbResp, nil
+		}
+		ttl = r.getEmptyCacheTTL(req)
+	}
+
+	dataToCache, marshalErr := r.encodeCachedResponse(dbResp)
 	if marshalErr != nil {
-		r.log.Errorf("traceId: %s failed to marshal db response for caching, key %s: %v. Returning DB response without caching.", traceId, cacheKey, marshalErr)
+		stats.fillErrors.Add(1)
+		r.log.WithContext(ctx).Errorf("failed to marshal db response for caching, key %s: %v. Returning DB response without caching.", cacheKey, marshalErr)
 		return dbResp, nil
 	}
 
-	setCmd := redisClient.Set(cacheKey, dataToCache, r.getCacheTTL(req))
+	setCmd := redisClient.Set(ctx, cacheKey, dataToCache, ttl)
 	if setCmd.Err() != nil {
-		r.log.Errorf("traceId: %s failed to set cache for key %s: %v. Returning DB response.", traceId, cacheKey, setCmd.Err())
+		stats.fillErrors.Add(1)
+		r.log.WithContext(ctx).Errorf("failed to set cache for key %s: %v. Returning DB response.", cacheKey, setCmd.Err())
+		return dbResp, nil
 	}
+	r.local.Set(localKey, dbResp)
 
 	return dbResp, nil
 }
 
-func (r *CachingDatalayerRepo) isCacheableCondition(wc *v1.WhereClause, cacheByField string) (bool, any) {
-	if wc == nil {
+// 验证 where 子句是否符合缓存模式：单字段为 “field = value”，多字段为 AND 嵌套的
+// “f1 = v1 AND f2 = v2 ...”，且恰好覆盖所有缓存字段。返回的值与 fields 顺序一致
+func (r *CachingDatalayerRepo) isCacheableCondition(wc *v1.WhereClause, fields []string) (bool, []any) {
+	if wc == nil || len(fields) == 0 {
 		return false, nil
 	}
 
-	condClause, ok := wc.ClauseType.(*v1.WhereClause_Condition)
-	if !ok {
+	conds := make(map[string]*v1.Condition, len(fields))
+	if !collectEqualityConditions(wc, conds) {
 		return false, nil
 	}
-
-	cond := condClause.Condition
-	if cond == nil {
+	if len(conds) != len(fields) {
 		return false, nil
 	}
 
-	if cond.Field != cacheByField {
-		return false, nil
+	values := make([]any, len(fields))
+	for i, field := range fields {
+		cond, ok := conds[field]
+		if !ok {
+			return false, nil
+		}
+
+		literalValueProvider, ok := cond.OperandType.(*v1.Condition_LiteralValue)
+		if !ok {
+			// 不符合简单缓存条件 "field = <literal_value>"
+			return false, nil
+		}
+		value, err := protobufValueToAny(literalValueProvider.LiteralValue)
+		if err != nil || !isCacheableValue(value) {
+			return false, nil
+		}
+		values[i] = value
 	}
 
-	if cond.Operator != v1.Operator_EQ {
-		return false, nil
+	return true, values
+}
+
+// 把 AND 嵌套的等值条件按字段收集起来，出现 OR、非等值或重复字段时返回 false
+func collectEqualityConditions(wc *v1.WhereClause, conds map[string]*v1.Condition) bool {
+	if wc == nil {
+		return false
 	}
 
-	literalValueProvider, ok := cond.OperandType.(*v1.Condition_LiteralValue)
-	if !ok {
-		// 不符合简单缓存条件 "field = <literal_value>"
-		return false, nil
+	switch clauseType := wc.ClauseType.(type) {
+	case *v1.WhereClause_Condition:
+		cond := clauseType.Condition
+		if cond == nil || cond.Field == "" || cond.Operator != v1.Operator_EQ {
+			return false
+		}
+		if _, ok := conds[cond.Field]; ok {
+			return false
+		}
+		conds[cond.Field] = cond
+		return true
+
+	case *v1.WhereClause_NestedClause:
+		nested := clauseType.NestedClause
+		if nested == nil || len(nested.Clauses) == 0 {
+			return false
+		}
+		// 未指定逻辑操作符时 buildWhereConditions 按 AND 处理
+		if nested.LogicalOperator == v1.LogicalOperator_OR {
+			return false
+		}
+		for _, sub := range nested.Clauses {
+			if !collectEqualityConditions(sub, conds) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return false
 	}
-	protoVal := literalValueProvider.LiteralValue
+}
 
-	value, err := protobufValueToAny(protoVal)
-	if err != nil {
-		return false, nil
+func isCacheableValue(value any) bool {
+	switch value.(type) {
+	case nil, []any, map[string]any:
+		return false
 	}
+	return true
+}
 
-	if value == nil {
-		return false, nil
+// 缓存字段去重、去空并排序，保证 key 的确定性
+func normalizeCacheFields(fields []string) []string {
+	if len(fields) == 0 {
+		return nil
 	}
-	switch value.(type) {
-	case []any, map[string]any:
-		return false, nil
+	normalized := make([]string, 0, len(fields))
+	seen := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if field == "" {
+			continue
+		}
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		normalized = append(normalized, field)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// 格式: [prefix]db:table:field1:value1[:field2:value2...]，值按 cacheKeyValue 编码
+func (r *CachingDatalayerRepo) buildCacheKey(ns *CacheNamespace, table *v1.TableSchema, fields []string, values []any) string {
+	var sb strings.Builder
+	sb.WriteString(ns.KeyPrefix)
+	sb.WriteString(table.DbName)
+	sb.WriteString(":")
+	sb.WriteString(table.TableName)
+	for i, field := range fields {
+		sb.WriteString(":")
+		sb.WriteString(field)
+		sb.WriteString(":")
+		sb.WriteString(cacheKeyValue(values[i]))
+	}
+	return sb.String()
+}
+
+// cacheKeyValue 编码缓存 key 中的值：字符串加引号，数字和布尔值加类型前缀，
+// 避免 1 与 "1"、含 ":" 的字符串与多个字段拼出相同的 key
+func cacheKeyValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		return "n" + strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return "b" + strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%T%q", v, fmt.Sprint(v))
 	}
+}
 
-	return true, value
+// 进程内缓存的 key 需要区分命名空间
+func (r *CachingDatalayerRepo) buildLocalCacheKey(ns *CacheNamespace, cacheKey string) string {
+	return ns.Name + ":" + cacheKey
 }
 
-func (r *CachingDatalayerRepo) buildCacheKey(table *v1.TableSchema, field string, value any) string {
-	return fmt.Sprintf("%s:%s:%s:%v", table.DbName, table.TableName, field, value)
+// 按名称查找缓存命名空间，请求指定了命名空间但未配置时记录告警
+func (r *CachingDatalayerRepo) getNamespace(ctx context.Context, name string, legacy v1.RedisDB) *CacheNamespace {
+	if name == "" && legacy <= v1.RedisDB_UNSPECIFIED {
+		return nil
+	}
+	ns := r.cache.GetNamespace(name, legacy)
+	if ns == nil {
+		r.log.WithContext(ctx).Warnf("cache namespace %q (redis db %s) is not configured, skip cache", name, legacy)
+	}
+	return ns
 }
 
-func (r *CachingDatalayerRepo) getCacheTTL(req *v1.QueryRequest) time.Duration {
+func (r *CachingDatalayerRepo) getCacheTTL(ns *CacheNamespace, req *v1.QueryRequest) time.Duration {
 	if req.CacheTtlSeconds > 0 {
 		return time.Duration(req.CacheTtlSeconds) * time.Second
 	}
-	return defaultCacheTTL
+	return ns.TTL
+}
+
+func (r *CachingDatalayerRepo) getEmptyCacheTTL(req *v1.QueryRequest) time.Duration {
+	if req.EmptyCacheTtlSeconds > 0 {
+		return time.Duration(req.EmptyCacheTtlSeconds) * time.Second
+	}
+	return defaultEmptyCacheTTL
+}
+
+func (r *CachingDatalayerRepo) encodeCachedResponse(resp *v1.QueryResponse) ([]byte, error) {
+	return r.codec.Encode(resp)
+}
+
+func (r *CachingDatalayerRepo) decodeCachedResponse(data []byte) (*v1.QueryResponse, error) {
+	return r.codec.Decode(data)
+}
+
+func isEmptyResponse(resp *v1.QueryResponse) bool {
+	return len(resp.Rows) == 0 && resp.TotalCount == 0
+}
+
+// 删除 redis 缓存并通知所有副本删除进程内缓存
+func (r *CachingDatalayerRepo) invalidateCache(ctx context.Context, ns *CacheNamespace, table *v1.TableSchema, cacheKeys ...string) error {
+	if len(cacheKeys) == 0 {
+		return nil
+	}
+	deleted, err := deleteKeys(ctx, ns.Client, cacheKeys...)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to delete cache keys %v: %v", cacheKeys, err)
+	}
+	r.stats.get(ns.Name, table).invalidations.Add(deleted)
+	for _, cacheKey := range cacheKeys {
+		r.local.Invalidate(ctx, r.buildLocalCacheKey(ns, cacheKey))
+	}
+	return err
+}
+
+// invalidateAfterWrite 清除写操作影响的缓存。事务中的写操作在提交后才清除，
+// 否则提交前的并发查询会把旧数据（包括空结果）重新写入缓存
+func (r *CachingDatalayerRepo) invalidateAfterWrite(ctx context.Context, transactionId string, ns *CacheNamespace, table *v1.TableSchema, cacheKeys ...string) {
+	if transactionId != "" && r.wrapped.data.AfterCommit(transactionId, func(ctx context.Context) {
+		_ = r.invalidateCache(ctx, ns, table, cacheKeys...)
+	}) {
+		return
+	}
+	_ = r.invalidateCache(ctx, ns, table, cacheKeys...)
+}
+
+// 按前缀删除 redis 缓存并通知所有副本删除进程内缓存，返回删除的 key 数量
+func (r *CachingDatalayerRepo) invalidatePrefix(ctx context.Context, ns *CacheNamespace, table *v1.TableSchema, prefix string) (int64, error) {
+	pattern := escapeRedisPattern(prefix) + "*"
+
+	var (
+		deleted int64
+		err     error
+	)
+	scanDelete := func(ctx context.Context, client redis.Cmdable) error {
+		n, err := scanDeleteKeys(ctx, client, pattern)
+		atomic.AddInt64(&deleted, n)
+		return err
+	}
+	// cluster 模式需要在每个主节点上分别 SCAN
+	if cluster, ok := ns.Client.(*redis.ClusterClient); ok {
+		err = cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+			return scanDelete(ctx, client)
+		})
+	} else {
+		err = scanDelete(ctx, ns.Client)
+	}
+	r.local.InvalidatePrefix(ctx, r.buildLocalCacheKey(ns, prefix))
+	r.stats.get(ns.Name, table).invalidations.Add(deleted)
+	return deleted, err
+}
+
+// 表级别的 key 前缀，table 为空时返回整个命名空间的前缀
+func (r *CachingDatalayerRepo) buildTablePrefix(ns *CacheNamespace, table *v1.TableSchema) string {
+	if table == nil || table.TableName == "" {
+		return ns.KeyPrefix
+	}
+	return ns.KeyPrefix + table.DbName + ":" + table.TableName + ":"
+}
+
+// 逐个删除 key，cluster 模式下多个 key 可能不在同一个 slot，不能用一条 DEL
+func deleteKeys(ctx context.Context, client redis.Cmdable, keys ...string) (int64, error) {
+	if len(keys) == 1 {
+		return client.Del(ctx, keys[0]).Result()
+	}
+	cmds, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.Del(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for _, cmd := range cmds {
+		deleted += cmd.(*redis.IntCmd).Val()
+	}
+	return deleted, nil
 }
 
 func (r *CachingDatalayerRepo) Insert(ctx context.Context, req *v1.InsertRequest) (*v1.MutationResponse, error) {
-	return r.wrapped.Insert(ctx, req)
+	resp, err := r.wrapped.Insert(ctx, req)
+	fields := normalizeCacheFields(req.CacheByField)
+	if err == nil && resp.AffectedRows > 0 && len(fields) > 0 {
+		ns := r.getNamespace(ctx, req.CacheNamespace, req.RedisDb)
+		if ns == nil {
+			return resp, err
+		}
+		// 新插入的行可能命中已缓存的 key（包括负缓存），需要清除
+		seen := make(map[string]struct{}, len(req.Rows))
+		cacheKeys := make([]string, 0, len(req.Rows))
+		for _, row := range req.Rows {
+			values, ok := rowCacheValues(row, fields)
+			if !ok {
+				continue
+			}
+			cacheKey := r.buildCacheKey(ns, req.Table, fields, values)
+			if _, ok := seen[cacheKey]; ok {
+				continue
+			}
+			seen[cacheKey] = struct{}{}
+			cacheKeys = append(cacheKeys, cacheKey)
+		}
+		r.invalidateAfterWrite(ctx, req.TransactionId, ns, req.Table, cacheKeys...)
+	}
+	return resp, err
 }
 
-func (r *CachingDatalayerRepo) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.MutationResponse, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
+// 取出行中所有缓存字段的值，任一字段缺失或不可缓存时返回 false
+func rowCacheValues(row *v1.Row, fields []string) ([]any, bool) {
+	if row == nil {
+		return nil, false
+	}
+	values := make([]any, len(fields))
+	for i, field := range fields {
+		value, err := protobufValueToAny(row.Fields[field])
+		if err != nil || !isCacheableValue(value) {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
 
+func (r *CachingDatalayerRepo) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.MutationResponse, error) {
 	resp, err := r.wrapped.Update(ctx, req)
-	if err == nil && resp.AffectedRows > 0 && req.CacheByField != "" && req.RedisDb > 0 {
-		cacheable, value := r.isCacheableCondition(req.WhereClause, req.CacheByField)
-		if cacheable {
-			redisClient := r.cache.GetRedis(int32(req.RedisDb))
-			if redisClient != nil {
-				cacheKey := r.buildCacheKey(req.Table, req.CacheByField, value)
-				redisClient.Del(cacheKey)
-			} else {
-				r.log.Warnf("traceId: %s failed to get redis client for db %s, skip delete cache. req: %+v", traceId, v1.RedisDB_name[int32(req.RedisDb)], req)
-			}
+	fields := normalizeCacheFields(req.CacheByField)
+	if err == nil && resp.AffectedRows > 0 && len(fields) > 0 {
+		cacheable, values := r.isCacheableCondition(req.WhereClause, fields)
+		if !cacheable {
+			return resp, err
+		}
+		if ns := r.getNamespace(ctx, req.CacheNamespace, req.RedisDb); ns != nil {
+			r.invalidateAfterWrite(ctx, req.TransactionId, ns, req.Table, r.buildCacheKey(ns, req.Table, fields, values))
 		}
 	}
 	return resp, err
 }
 
 func (r *CachingDatalayerRepo) Delete(ctx context.Context, req *v1.DeleteRequest) (*v1.MutationResponse, error) {
-	traceId := md.GetMetadata(ctx, global.RequestIdMd)
-
 	resp, err := r.wrapped.Delete(ctx, req)
-	if err == nil && resp.AffectedRows > 0 && req.CacheByField != "" && req.RedisDb > 0 {
-		cacheable, value := r.isCacheableCondition(req.WhereClause, req.CacheByField)
-		if cacheable {
-			redisClient := r.cache.GetRedis(int32(req.RedisDb))
-			if redisClient != nil {
-				cacheKey := r.buildCacheKey(req.Table, req.CacheByField, value)
-				redisClient.Del(cacheKey)
-			} else {
-				r.log.Warnf("traceId: %s failed to get redis client for db %s, skip delete cache. req: %+v", traceId, v1.RedisDB_name[int32(req.RedisDb)], req)
-			}
+	fields := normalizeCacheFields(req.CacheByField)
+	if err == nil && resp.AffectedRows > 0 && len(fields) > 0 {
+		cacheable, values := r.isCacheableCondition(req.WhereClause, fields)
+		if !cacheable {
+			return resp, err
+		}
+		if ns := r.getNamespace(ctx, req.CacheNamespace, req.RedisDb); ns != nil {
+			r.invalidateAfterWrite(ctx, req.TransactionId, ns, req.Table, r.buildCacheKey(ns, req.Table, fields, values))
 		}
 	}
 	return resp, err
@@ -215,6 +491,10 @@ func (r *CachingDatalayerRepo) RollbackTransaction(ctx context.Context, req *v1.
 	return r.wrapped.RollbackTransaction(ctx, req)
 }
 
+func (r *CachingDatalayerRepo) ListDatabases(ctx context.Context, req *v1.ListDatabasesRequest) (*v1.ListDatabasesResponse, error) {
+	return r.wrapped.ListDatabases(ctx, req)
+}
+
 func (r *CachingDatalayerRepo) ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ListTablesResponse, error) {
 	return r.wrapped.ListTables(ctx, req)
 }
@@ -0,0 +1,90 @@
+package data
+
+import (
+	v1 "datahub/api/datalayer/v1"
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/schema"
+)
+
+func TestBuildCacheKeyNoCollision(t *testing.T) {
+	r := &CachingDatalayerRepo{}
+	ns := &CacheNamespace{Name: "test"}
+	table := &v1.TableSchema{DbName: "db", TableName: "t"}
+
+	tests := []struct {
+		name   string
+		fields [2][]string
+		values [2][]any
+	}{
+		{
+			name:   "separator in value",
+			fields: [2][]string{{"a"}, {"a", "b"}},
+			values: [2][]any{{"x:b:y"}, {"x", "y"}},
+		},
+		{
+			name:   "number and string",
+			fields: [2][]string{{"id"}, {"id"}},
+			values: [2][]any{{float64(1)}, {"1"}},
+		},
+		{
+			name:   "bool and string",
+			fields: [2][]string{{"on"}, {"on"}},
+			values: [2][]any{{true}, {"true"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k1 := r.buildCacheKey(ns, table, tt.fields[0], tt.values[0])
+			k2 := r.buildCacheKey(ns, table, tt.fields[1], tt.values[1])
+			if k1 == k2 {
+				t.Fatalf("keys collide: %s", k1)
+			}
+		})
+	}
+}
+
+func TestBuildCacheKeyMatchesBinlogValues(t *testing.T) {
+	r := &CachingDatalayerRepo{}
+	ns := &CacheNamespace{Name: "test"}
+	table := &v1.TableSchema{DbName: "db", TableName: "t"}
+	fields := []string{"id", "name"}
+
+	binlogTable := &schema.Table{Columns: []schema.TableColumn{
+		{Name: "id", Type: schema.TYPE_NUMBER},
+		{Name: "name", Type: schema.TYPE_STRING},
+	}}
+	values, ok := binlogCacheValues(binlogTable, []interface{}{int64(42), []byte("dev:1")}, fields)
+	if !ok {
+		t.Fatal("binlog row has no cache values")
+	}
+
+	query := r.buildCacheKey(ns, table, fields, []any{float64(42), "dev:1"})
+	binlog := r.buildCacheKey(ns, table, fields, values)
+	if query != binlog {
+		t.Fatalf("query key %s != binlog key %s", query, binlog)
+	}
+}
+
+func TestBinlogCacheValueTime(t *testing.T) {
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 500_000_000, time.Local)
+	tests := []struct {
+		name string
+		col  schema.TableColumn
+		want string
+	}{
+		{name: "date", col: schema.TableColumn{Type: schema.TYPE_DATE, RawType: "date"}, want: "2024-05-06"},
+		{name: "datetime", col: schema.TableColumn{Type: schema.TYPE_DATETIME, RawType: "datetime"}, want: "2024-05-06 07:08:09"},
+		{name: "datetime fsp", col: schema.TableColumn{Type: schema.TYPE_DATETIME, RawType: "datetime(3)"}, want: "2024-05-06 07:08:09.500"},
+		{name: "timestamp", col: schema.TableColumn{Type: schema.TYPE_TIMESTAMP, RawType: "timestamp"}, want: "2024-05-06 07:08:09"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := binlogCacheValue(&tt.col, ts)
+			if !ok || v != tt.want {
+				t.Fatalf("got %v, %v, want %s", v, ok, tt.want)
+			}
+		})
+	}
+}
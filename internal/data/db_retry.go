@@ -3,6 +3,8 @@ package data
 import (
 	"context"
 	"database/sql/driver"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
 	"errors"
 	"fmt"
 	"io"
@@ -21,7 +23,10 @@ type RetryOptions struct {
 	Timeout        time.Duration
 	InitialBackoff time.Duration
 	MaxBackoff     time.Duration
+	MaxAttempts    int // 包括第一次，0 表示只受 Timeout 限制
 	Logger         *log.Helper
+	// 判断错误能否重试，nil 时为 IsConnError
+	Retryable func(error) bool
 }
 
 var DefaultRetryOptions = RetryOptions{
@@ -30,10 +35,31 @@ var DefaultRetryOptions = RetryOptions{
 	MaxBackoff:     2 * time.Second,
 }
 
-func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, opt *RetryOptions) error {
-	if opt == nil {
-		tmp := DefaultRetryOptions
-		opt = &tmp
+// 按配置生成重试参数，未配置的项使用默认值
+func newRetryOptions(c *conf.Data_Retry, logger *log.Helper) *RetryOptions {
+	opt := DefaultRetryOptions
+	opt.Logger = logger
+	if c == nil {
+		return &opt
+	}
+	if d := c.Timeout.AsDuration(); d > 0 {
+		opt.Timeout = d
+	}
+	if d := c.InitialBackoff.AsDuration(); d > 0 {
+		opt.InitialBackoff = d
+	}
+	if d := c.MaxBackoff.AsDuration(); d > 0 {
+		opt.MaxBackoff = d
+	}
+	opt.MaxAttempts = int(c.MaxAttempts)
+	return &opt
+}
+
+func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, options *RetryOptions) error {
+	// 复制一份，调用方的配置可能被多个请求共享
+	opt := DefaultRetryOptions
+	if options != nil {
+		opt = *options
 	}
 	if opt.Timeout <= 0 {
 		opt.Timeout = DefaultRetryOptions.Timeout
@@ -44,6 +70,9 @@ func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, opt
 	if opt.MaxBackoff <= 0 {
 		opt.MaxBackoff = DefaultRetryOptions.MaxBackoff
 	}
+	if opt.Retryable == nil {
+		opt.Retryable = IsConnError
+	}
 
 	deadline := time.Now().Add(opt.Timeout) // 计算超时截止
 	attempt := 0                            // 第几次尝试
@@ -62,8 +91,8 @@ func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, opt
 		}
 		lastErr = err
 
-		// 非连接类错误：直接返回
-		if !IsConnError(err) {
+		// 不可重试的错误：直接返回
+		if !opt.Retryable(err) {
 			return err
 		}
 
@@ -71,6 +100,9 @@ func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, opt
 		if now.After(deadline) {
 			return fmt.Errorf("db operation failed after %d attempts within %s: %w", attempt, opt.Timeout, lastErr)
 		}
+		if opt.MaxAttempts > 0 && attempt >= opt.MaxAttempts {
+			return fmt.Errorf("db operation failed after %d attempts: %w", attempt, lastErr)
+		}
 
 		// 计算退避（指数退避 + 全抖动）
 		exp := min(attempt-1, 10)
@@ -93,6 +125,78 @@ func withRetry(ctx context.Context, db *gorm.DB, op func(tx *gorm.DB) error, opt
 	}
 }
 
+// retryRead 在副本或主库上执行只读操作，连接错误时按重试预算重试。
+// 副本出错时先摘除副本，再到主库上重试
+func (r *DatalayerRepo) retryRead(ctx context.Context, dbName string, consistency v1.Consistency, op func(tx *gorm.DB) error) error {
+	db, replica, release, err := r.data.readDB(dbName, consistency)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return r.data.guarded(ctx, dbName, func() error {
+		if replica {
+			err := op(db.WithContext(ctx))
+			if !IsConnError(err) {
+				return err
+			}
+			r.log.WithContext(ctx).Warnf("read failed on replica of database %s, retrying on primary: %v", dbName, err)
+			r.data.replicaSet(dbName).ReportError(db, err)
+			primary, releasePrimary, err := r.data.database(dbName)
+			if err != nil {
+				return err
+			}
+			defer releasePrimary()
+			db = primary
+		}
+		return withRetry(ctx, db, op, r.retry)
+	})
+}
+
+// mutate 执行写操作。只有不在事务中且携带幂等键的请求才会重试，并且只重试语句确定没有发出的错误：
+// 读超时、连接中断等错误发生时语句可能已经提交，重试会重复写入
+func (r *DatalayerRepo) mutate(ctx context.Context, dbName string, db *gorm.DB, retry bool, op func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	var affected int64
+	err := r.data.guarded(ctx, dbName, func() error {
+		if !retry {
+			result := op(db.WithContext(ctx))
+			affected = result.RowsAffected
+			return result.Error
+		}
+		opt := DefaultRetryOptions
+		if r.retry != nil {
+			opt = *r.retry
+		}
+		opt.Retryable = IsUnsentError
+		return withRetry(ctx, db, func(tx *gorm.DB) error {
+			result := op(tx)
+			affected = result.RowsAffected
+			return result.Error
+		}, &opt)
+	})
+	return affected, err
+}
+
+// IsUnsentError 判断错误是否发生在语句发出之前，如建立连接失败、连接池中的连接已失效，
+// 这类错误重试写操作不会重复执行
+func IsUnsentError(err error) bool {
+	if err == nil {
+		return false
+	}
+	// database/sql 和 mysql 驱动只在没有写出任何数据时返回 ErrBadConn
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var operr *net.OpError
+	if errors.As(err, &operr) && strings.ToLower(operr.Op) == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	return isPostgresUnsentError(err)
+}
+
 func IsConnError(err error) bool {
 	if err == nil {
 		return false
@@ -140,6 +244,11 @@ func IsConnError(err error) bool {
 		}
 	}
 
+	// Postgres 断链/网络相关错误
+	if isPostgresConnError(err) {
+		return true
+	}
+
 	// 兜底：字符串匹配（覆盖 broken pipe / connection reset 等）
 	msg := strings.ToLower(err.Error())
 	bits := []string{
@@ -152,6 +261,9 @@ func IsConnError(err error) bool {
 		"i/o timeout",
 		"server has gone away",
 		"lost connection to mysql server",
+		"conn closed",
+		"unexpected eof",
+		"terminating connection due to administrator command",
 		"bad connection",
 	}
 	for _, s := range bits {
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gosqlite "github.com/glebarez/go-sqlite"
+	"github.com/glebarez/sqlite"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	driverMySQL    = "mysql"
+	driverPostgres = "postgres"
+	driverSQLite   = "sqlite"
+
+	mysqlErrDuplicateEntry    = 1062
+	postgresUniqueViolation   = "23505"
+	postgresConnExceptionCode = "08" // SQLSTATE 08xxx 都是连接异常
+
+	// 未在 dsn 中指定时追加的 sqlite 参数
+	sqliteDefaultPragmas = "_pragma=busy_timeout(5000)&_pragma=foreign_keys(1)"
+)
+
+// 按配置的驱动名称创建 gorm Dialector，未配置时默认为 mysql
+func openDialector(driver, dsn string) (gorm.Dialector, error) {
+	switch strings.ToLower(driver) {
+	case "", driverMySQL:
+		return mysql.Open(dsn), nil
+	case driverPostgres, "postgresql", "pgx":
+		return postgres.Open(dsn), nil
+	case driverSQLite, "sqlite3":
+		return sqlite.Open(sqliteDSN(dsn)), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
+func isSQLite(driver string) bool {
+	switch strings.ToLower(driver) {
+	case driverSQLite, "sqlite3":
+		return true
+	}
+	return false
+}
+
+// 补充默认的 pragma；文件数据库开启 WAL，减少读写互相阻塞
+func sqliteDSN(dsn string) string {
+	if dsn == "" {
+		dsn = ":memory:"
+	}
+	if strings.Contains(dsn, "_pragma=") {
+		return dsn
+	}
+	pragmas := sqliteDefaultPragmas
+	if !isSQLiteMemory(dsn) {
+		pragmas += "&_pragma=journal_mode(WAL)"
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&" + pragmas
+	}
+	if !strings.HasPrefix(dsn, "file:") {
+		dsn = "file:" + dsn
+	}
+	return dsn + "?" + pragmas
+}
+
+// 内存数据库每个连接都是独立的库，需要限制为单连接
+func isSQLiteMemory(dsn string) bool {
+	return dsn == "" || strings.Contains(dsn, ":memory:") || strings.Contains(dsn, "mode=memory")
+}
+
+// tableExists 判断表是否存在。gorm 的 Migrator.HasTable 会忽略查询错误，连接失败时也返回 false
+func tableExists(tx *gorm.DB, table string) (bool, error) {
+	var count int64
+	var err error
+	switch tx.Dialector.Name() {
+	case driverSQLite:
+		err = tx.Raw("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count).Error
+	case driverPostgres:
+		schema := clause.Expr{SQL: "CURRENT_SCHEMA()"}
+		if before, after, ok := strings.Cut(table, "."); ok {
+			schema, table = clause.Expr{SQL: "?", Vars: []any{before}}, after
+		}
+		err = tx.Raw("SELECT count(*) FROM information_schema.tables WHERE table_schema = ? AND table_name = ? AND table_type = 'BASE TABLE'", schema, table).Scan(&count).Error
+	default:
+		err = tx.Raw("SELECT count(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ? AND table_type = 'BASE TABLE'", table).Scan(&count).Error
+	}
+	return count > 0, err
+}
+
+// 唯一键冲突，兼容 mysql、postgres 和 sqlite
+func isDuplicateKeyError(err error) bool {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+	var my *mysqlDriver.MySQLError
+	if errors.As(err, &my) {
+		return my.Number == mysqlErrDuplicateEntry
+	}
+	var pg *pgconn.PgError
+	if errors.As(err, &pg) {
+		return pg.Code == postgresUniqueViolation
+	}
+	var lite *gosqlite.Error
+	if errors.As(err, &lite) {
+		return lite.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || lite.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+	}
+	return false
+}
+
+// postgres 中确定没有发出请求的错误，SafeToRetry 表示没有向服务端发送任何数据
+func isPostgresUnsentError(err error) bool {
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+	var connectErr *pgconn.ConnectError
+	return errors.As(err, &connectErr)
+}
+
+// postgres 的连接类错误
+func isPostgresConnError(err error) bool {
+	if pgconn.Timeout(err) || pgconn.SafeToRetry(err) {
+		return true
+	}
+	var pg *pgconn.PgError
+	if errors.As(err, &pg) {
+		switch {
+		case strings.HasPrefix(pg.Code, postgresConnExceptionCode):
+			return true
+		case pg.Code == "57P01", pg.Code == "57P02", pg.Code == "57P03": // admin_shutdown, crash_shutdown, cannot_connect_now
+			return true
+		}
+	}
+	var connectErr *pgconn.ConnectError
+	return errors.As(err, &connectErr)
+}
@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"datahub/internal/conf"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultHealthInterval = 5 * time.Second
+	defaultHealthTimeout  = 2 * time.Second
+
+	healthRedisDependency = "redis"
+)
+
+// HealthChecker 实现 grpc.health.v1，定期 ping 所有数据库主库和 redis。
+// 每个依赖单独上报状态（服务名为 "database/<name>" 和 "redis"），
+// 空服务名表示整体状态，关键依赖不可用或服务停止中时为 NOT_SERVING
+type HealthChecker struct {
+	*health.Server
+
+	data     *Data
+	cache    *RedisClient
+	interval time.Duration
+	timeout  time.Duration
+	optional map[string]bool
+	log      *log.Helper
+
+	mu       sync.Mutex
+	statuses map[string]bool // 依赖名称 -> 是否可用
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewHealthChecker(c *conf.Data, data *Data, cache *RedisClient, logger log.Logger) *HealthChecker {
+	h := &HealthChecker{
+		Server:   health.NewServer(),
+		data:     data,
+		cache:    cache,
+		interval: c.Health.GetInterval().AsDuration(),
+		timeout:  c.Health.GetTimeout().AsDuration(),
+		optional: make(map[string]bool),
+		log:      log.NewHelper(logger),
+		statuses: make(map[string]bool),
+	}
+	if h.interval <= 0 {
+		h.interval = defaultHealthInterval
+	}
+	if h.timeout <= 0 {
+		h.timeout = defaultHealthTimeout
+	}
+	for _, name := range c.Health.GetOptionalDependencies() {
+		h.optional[name] = true
+	}
+	// 第一次检查完成前不接收流量
+	h.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	data.onDrain(func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	})
+	return h
+}
+
+// Start 立即检查一次，之后按间隔检查，直到 Stop 被调用
+func (h *HealthChecker) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	h.mu.Lock()
+	h.cancel, h.done = cancel, done
+	h.mu.Unlock()
+	defer close(done)
+
+	h.check(ctx)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.check(ctx)
+		}
+	}
+}
+
+// Stop 停止检查并把所有服务标记为 NOT_SERVING
+func (h *HealthChecker) Stop(_ context.Context) error {
+	h.Shutdown()
+	h.mu.Lock()
+	cancel, done := h.cancel, h.done
+	h.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	return nil
+}
+
+func (h *HealthChecker) check(ctx context.Context) {
+	results := make(map[string]error)
+	for name, db := range h.data.primaries() {
+		results["database/"+name] = h.pingDatabase(ctx, db)
+		db.release()
+	}
+	if len(h.cache.clients) > 0 {
+		results[healthRedisDependency] = h.pingRedis(ctx)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// 热更新移除的数据库
+	for name := range h.statuses {
+		if _, ok := results[name]; !ok {
+			delete(h.statuses, name)
+			h.SetServingStatus(name, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+		}
+	}
+
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	serving := true
+	for _, name := range names {
+		err := results[name]
+		healthy := err == nil
+		if prev, ok := h.statuses[name]; !ok || prev != healthy {
+			if healthy {
+				h.log.Infof("health check: %s is serving", name)
+			} else {
+				h.log.Warnf("health check: %s is not serving: %v", name, err)
+			}
+		}
+		h.statuses[name] = healthy
+		h.SetServingStatus(name, servingStatus(healthy))
+		if !healthy && !h.optional[name] {
+			serving = false
+		}
+	}
+	// 停止流程中不再接收新的流量
+	if h.data.draining.Load() {
+		serving = false
+	}
+	h.SetServingStatus("", servingStatus(serving))
+}
+
+// 主库 ping 不通或熔断打开时视为不可用
+func (h *HealthChecker) pingDatabase(ctx context.Context, db *databaseProbe) error {
+	if db.guard != nil && db.guard.state() == breakerOpen.String() {
+		return fmt.Errorf("circuit breaker is open")
+	}
+	sqlDB, err := db.db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+func (h *HealthChecker) pingRedis(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	for num, client := range h.cache.clients {
+		if err := client.Ping(ctx).Err(); err != nil {
+			return fmt.Errorf("redis db %d: %w", num, err)
+		}
+	}
+	return nil
+}
+
+type databaseProbe struct {
+	db      *gorm.DB
+	guard   *databaseGuard
+	release func()
+}
+
+// primaries 返回当前所有数据库的主库，检查期间不持有锁，检查后需调用 release
+func (d *Data) primaries() map[string]*databaseProbe {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+	probes := make(map[string]*databaseProbe, len(d.db))
+	for name, db := range d.db {
+		probes[name] = &databaseProbe{db: db, guard: d.guards[name], release: d.hold(db)}
+	}
+	return probes
+}
+
+func servingStatus(healthy bool) healthpb.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,183 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultIdempotencyTTL         = 24 * time.Hour
+	defaultIdempotencyLockTimeout = time.Minute
+	defaultIdempotencyKeyPrefix   = "datahub:idempotency:"
+)
+
+// IdempotencyStore 保存携带幂等键的写操作结果，窗口内重复的请求直接返回保存的结果。
+// 结果保存在 redis 的 state_db 中
+type IdempotencyStore struct {
+	client      redis.UniversalClient
+	ttl         time.Duration
+	lockTimeout time.Duration
+	prefix      string
+	log         *log.Helper
+}
+
+// 执行中的请求只保存请求指纹，完成后写入结果
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Unknown     bool   `json:"unknown,omitempty"`  // 执行出错但语句可能已经提交
+	Response    []byte `json:"response,omitempty"` // MutationResponse 的 protobuf 编码
+}
+
+func NewIdempotencyStore(c *conf.Data, cache *RedisClient, logger log.Logger) (*IdempotencyStore, error) {
+	s := &IdempotencyStore{
+		ttl:         c.Idempotency.GetTtl().AsDuration(),
+		lockTimeout: c.Idempotency.GetLockTimeout().AsDuration(),
+		prefix:      c.Idempotency.GetKeyPrefix(),
+		log:         log.NewHelper(logger),
+	}
+	if s.ttl <= 0 {
+		s.ttl = defaultIdempotencyTTL
+	}
+	if s.lockTimeout <= 0 {
+		s.lockTimeout = defaultIdempotencyLockTimeout
+	}
+	if s.prefix == "" {
+		s.prefix = defaultIdempotencyKeyPrefix
+	}
+	// 结果被清除后重试的请求会再执行一次，不能放在会被清空的缓存命名空间中
+	client, err := cache.stateClient(s.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency store: %w", err)
+	}
+	s.client = client
+	return s, nil
+}
+
+// Do 按幂等键执行写操作。key 为空时直接执行；相同的 key 已有结果时返回保存的结果，
+// 正在执行或请求内容不同时返回 Conflict。redis 不可用时不做去重
+func (s *IdempotencyStore) Do(ctx context.Context, op, dbName, key string, req proto.Message, exec func() (*v1.MutationResponse, error)) (*v1.MutationResponse, error) {
+	if s == nil || key == "" {
+		return exec()
+	}
+
+	fingerprint, err := idempotencyFingerprint(req)
+	if err != nil {
+		return nil, errors.BadRequest(v1.ReasonInvalidArgument, fmt.Sprintf("encode request: %v", err))
+	}
+	redisKey := s.prefix + op + ":" + dbName + ":" + key
+
+	pending, _ := json.Marshal(&idempotencyRecord{Fingerprint: fingerprint})
+	acquired, err := s.client.SetNX(ctx, redisKey, pending, s.lockTimeout).Result()
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("acquire idempotency key %s error, executing without deduplication: %v", redisKey, err)
+		return exec()
+	}
+	if !acquired {
+		return s.stored(ctx, redisKey, key, fingerprint)
+	}
+
+	resp, err := exec()
+	if err != nil {
+		if writeNotApplied(err) {
+			// 确定没有写入的请求不保存，允许客户端用同一个 key 重试
+			if delErr := s.client.Del(context.WithoutCancel(ctx), redisKey).Err(); delErr != nil {
+				s.log.WithContext(ctx).Warnf("release idempotency key %s error: %v", redisKey, delErr)
+			}
+			return nil, err
+		}
+		// 超时、连接中断时语句可能已经提交，保留记录，避免同一个 key 的重试再写一次
+		data, _ := json.Marshal(&idempotencyRecord{Fingerprint: fingerprint, Unknown: true})
+		if setErr := s.client.Set(context.WithoutCancel(ctx), redisKey, data, s.ttl).Err(); setErr != nil {
+			s.log.WithContext(ctx).Errorf("mark idempotency key %s as unknown outcome error: %v", redisKey, setErr)
+		}
+		return nil, err
+	}
+
+	body, err := proto.Marshal(resp)
+	if err == nil {
+		var data []byte
+		if data, err = json.Marshal(&idempotencyRecord{Fingerprint: fingerprint, Done: true, Response: body}); err == nil {
+			err = s.client.Set(context.WithoutCancel(ctx), redisKey, data, s.ttl).Err()
+		}
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("save idempotency result for key %s error: %v", redisKey, err)
+	}
+	return resp, nil
+}
+
+func (s *IdempotencyStore) stored(ctx context.Context, redisKey, key, fingerprint string) (*v1.MutationResponse, error) {
+	data, err := s.client.Get(ctx, redisKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// 上一个请求刚好失败或过期，由客户端重试
+		return nil, errors.Conflict(v1.ReasonIdempotencyInProgress, fmt.Sprintf("request with idempotency key %s is in progress", key))
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("load idempotency key %s error: %v", redisKey, err)
+		return nil, errors.ServiceUnavailable(v1.ReasonIdempotencyStoreUnavailable, fmt.Sprintf("load idempotency key %s: %v", key, err))
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errors.InternalServer(v1.ReasonIdempotencyRecordCorrupted, fmt.Sprintf("decode idempotency key %s: %v", key, err))
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, errors.Conflict(v1.ReasonIdempotencyKeyReused, fmt.Sprintf("idempotency key %s was used by a different request", key))
+	}
+	if record.Unknown {
+		return nil, errors.Conflict(v1.ReasonIdempotencyOutcomeUnknown, fmt.Sprintf("request with idempotency key %s failed and may have been applied, check the data before retrying with a new key", key))
+	}
+	if !record.Done {
+		return nil, errors.Conflict(v1.ReasonIdempotencyInProgress, fmt.Sprintf("request with idempotency key %s is in progress", key))
+	}
+
+	resp := &v1.MutationResponse{}
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, errors.InternalServer(v1.ReasonIdempotencyRecordCorrupted, fmt.Sprintf("decode idempotency result %s: %v", key, err))
+	}
+	s.log.WithContext(ctx).Infof("returning stored result for idempotency key %s", redisKey)
+	return resp, nil
+}
+
+// writeNotApplied 判断失败的写操作是否确定没有生效：语句没有发出、参数校验失败或被约束拒绝
+func writeNotApplied(err error) bool {
+	if IsUnsentError(err) || isDuplicateKeyError(err) {
+		return true
+	}
+	var se *errors.Error
+	if !errors.As(err, &se) {
+		return false
+	}
+	// 4xx 在执行前校验失败；503 是熔断、并发名额或停止中，语句没有执行
+	return (se.Code >= 400 && se.Code < 500) || se.Code == 503
+}
+
+// 请求内容的指纹，用于识别同一个幂等键被不同请求复用
+func idempotencyFingerprint(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// 事务中的写操作可能被回滚，不使用幂等键
+func mutationIdempotencyKey(transactionId, key string) string {
+	if transactionId != "" {
+		return ""
+	}
+	return key
+}
@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	v1 "datahub/api/datalayer/v1"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// fakeRedis 只实现幂等记录用到的命令，不处理过期时间
+type fakeRedis struct {
+	redis.UniversalClient
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{data: make(map[string]string)}
+}
+
+func (f *fakeRedis) SetNX(_ context.Context, key string, value interface{}, _ time.Duration) *redis.BoolCmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.data[key]; ok {
+		return redis.NewBoolResult(false, nil)
+	}
+	f.data[key] = fmt.Sprintf("%s", value)
+	return redis.NewBoolResult(true, nil)
+}
+
+func (f *fakeRedis) Set(_ context.Context, key string, value interface{}, _ time.Duration) *redis.StatusCmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.data[key] = fmt.Sprintf("%s", value)
+	return redis.NewStatusResult("OK", nil)
+}
+
+func (f *fakeRedis) Get(_ context.Context, key string) *redis.StringCmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v, ok := f.data[key]
+	if !ok {
+		return redis.NewStringResult("", redis.Nil)
+	}
+	return redis.NewStringResult(v, nil)
+}
+
+func (f *fakeRedis) Del(_ context.Context, keys ...string) *redis.IntCmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var n int64
+	for _, key := range keys {
+		if _, ok := f.data[key]; ok {
+			delete(f.data, key)
+			n++
+		}
+	}
+	return redis.NewIntResult(n, nil)
+}
+
+func TestIdempotencyStoreFailedExec(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		reExecuted bool
+		reason     string
+	}{
+		{
+			// 语句已经提交，返回前超时
+			name:   "committed then deadline exceeded",
+			err:    errors.InternalServer(v1.ReasonInsertFailed, "timeout").WithCause(context.DeadlineExceeded),
+			reason: v1.ReasonIdempotencyOutcomeUnknown,
+		},
+		{
+			name:       "not sent",
+			err:        errors.InternalServer(v1.ReasonInsertFailed, "bad conn").WithCause(driver.ErrBadConn),
+			reExecuted: true,
+		},
+		{
+			name:       "validation",
+			err:        errors.BadRequest(v1.ReasonInvalidArgument, "rows cannot be empty"),
+			reExecuted: true,
+		},
+		{
+			name:       "circuit breaker open",
+			err:        errors.ServiceUnavailable(v1.ReasonUnavailable, "circuit breaker is open"),
+			reExecuted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &IdempotencyStore{
+				client:      newFakeRedis(),
+				ttl:         time.Hour,
+				lockTimeout: time.Minute,
+				prefix:      defaultIdempotencyKeyPrefix,
+				log:         log.NewHelper(log.DefaultLogger),
+			}
+			req := &v1.InsertRequest{Table: &v1.TableSchema{DbName: "db", TableName: "t"}, IdempotencyKey: "k"}
+
+			calls := 0
+			_, err := s.Do(context.Background(), "insert", "db", "k", req, func() (*v1.MutationResponse, error) {
+				calls++
+				return nil, tt.err
+			})
+			if err == nil {
+				t.Fatal("first call succeeded")
+			}
+
+			_, err = s.Do(context.Background(), "insert", "db", "k", req, func() (*v1.MutationResponse, error) {
+				calls++
+				return &v1.MutationResponse{AffectedRows: 1}, nil
+			})
+			if tt.reExecuted {
+				if calls != 2 || err != nil {
+					t.Fatalf("retry not executed: calls=%d err=%v", calls, err)
+				}
+				return
+			}
+			if calls != 1 {
+				t.Fatalf("retry executed again: calls=%d", calls)
+			}
+			if errors.Reason(err) != tt.reason {
+				t.Fatalf("got %v, want reason %s", err, tt.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultLocalCacheMaxEntries = 10000
+	defaultLocalCacheMaxBytes   = 64 << 20
+	defaultLocalCacheTTL        = 5 * time.Second
+	defaultInvalidateChannel    = "datahub:cache:invalidate"
+
+	// 按前缀失效的消息以此开头，其余消息均为单个 key
+	invalidatePrefixMarker = "\x00prefix:"
+)
+
+// LocalCache 是位于 redis 之前的进程内 LRU 缓存，按条目数和字节数限制容量。
+// 缓存的是反序列化后的 QueryResponse，调用方只能读取，不能修改。
+// 未启用时为 nil，所有方法都可以在 nil 上安全调用。
+type LocalCache struct {
+	mu         sync.Mutex
+	ll         *list.List
+	items      map[string]*list.Element
+	bytes      int64
+	maxEntries int
+	maxBytes   int64
+	ttl        time.Duration
+
+	channel string
+	pubsub  redis.UniversalClient
+	log     *log.Helper
+}
+
+type localCacheEntry struct {
+	key      string
+	value    *v1.QueryResponse
+	size     int64
+	expireAt time.Time
+}
+
+func NewLocalCache(c *conf.Data, cache *RedisClient, logger log.Logger) (*LocalCache, func(), error) {
+	lc := c.LocalCache
+	if lc == nil || !lc.Enabled {
+		return nil, func() {}, nil
+	}
+
+	l := &LocalCache{
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+		maxEntries: int(lc.MaxEntries),
+		maxBytes:   lc.MaxBytes,
+		ttl:        lc.Ttl.AsDuration(),
+		channel:    lc.InvalidateChannel,
+		pubsub:     cache.pubSubClient(),
+		log:        log.NewHelper(logger),
+	}
+	if l.maxEntries <= 0 {
+		l.maxEntries = defaultLocalCacheMaxEntries
+	}
+	if l.maxBytes <= 0 {
+		l.maxBytes = defaultLocalCacheMaxBytes
+	}
+	if l.ttl <= 0 {
+		l.ttl = defaultLocalCacheTTL
+	}
+	if l.channel == "" {
+		l.channel = defaultInvalidateChannel
+	}
+	if l.pubsub == nil {
+		return nil, nil, fmt.Errorf("local cache requires a redis client for invalidation")
+	}
+
+	// 订阅其他副本发出的失效消息
+	ps := l.pubsub.Subscribe(context.Background(), l.channel)
+	if _, err := ps.Receive(context.Background()); err != nil {
+		_ = ps.Close()
+		l.log.Errorf("subscribe to local cache invalidate channel %s error: %v", l.channel, err)
+		return nil, nil, err
+	}
+	go func() {
+		for msg := range ps.Channel() {
+			if prefix, ok := strings.CutPrefix(msg.Payload, invalidatePrefixMarker); ok {
+				l.removePrefix(prefix)
+			} else {
+				l.remove(msg.Payload)
+			}
+		}
+	}()
+
+	cleanup := func() {
+		_ = ps.Close()
+	}
+	return l, cleanup, nil
+}
+
+func (l *LocalCache) Get(key string) (*v1.QueryResponse, bool) {
+	if l == nil {
+		return nil, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*localCacheEntry)
+	if time.Now().After(entry.expireAt) {
+		l.removeElement(elem)
+		return nil, false
+	}
+	l.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (l *LocalCache) Set(key string, value *v1.QueryResponse) {
+	if l == nil {
+		return
+	}
+	size := int64(proto.Size(value)) + int64(len(key))
+	if size > l.maxBytes {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.items[key]; ok {
+		l.removeElement(elem)
+	}
+	elem := l.ll.PushFront(&localCacheEntry{
+		key:      key,
+		value:    value,
+		size:     size,
+		expireAt: time.Now().Add(l.ttl),
+	})
+	l.items[key] = elem
+	l.bytes += size
+
+	// 超出容量时从尾部淘汰
+	for l.ll.Len() > l.maxEntries || l.bytes > l.maxBytes {
+		l.removeElement(l.ll.Back())
+	}
+}
+
+// Invalidate 删除本地条目，并通知所有副本删除同一个 key
+func (l *LocalCache) Invalidate(ctx context.Context, key string) {
+	if l == nil {
+		return
+	}
+	l.remove(key)
+	if err := l.pubsub.Publish(ctx, l.channel, key).Err(); err != nil {
+		l.log.Errorf("failed to publish local cache invalidation for key %s: %v", key, err)
+	}
+}
+
+// InvalidatePrefix 删除本地所有以 prefix 开头的条目，并通知所有副本
+func (l *LocalCache) InvalidatePrefix(ctx context.Context, prefix string) {
+	if l == nil {
+		return
+	}
+	l.removePrefix(prefix)
+	if err := l.pubsub.Publish(ctx, l.channel, invalidatePrefixMarker+prefix).Err(); err != nil {
+		l.log.Errorf("failed to publish local cache invalidation for prefix %s: %v", prefix, err)
+	}
+}
+
+// Contains 判断 key 是否在本地缓存中且未过期
+func (l *LocalCache) Contains(key string) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	elem, ok := l.items[key]
+	return ok && time.Now().Before(elem.Value.(*localCacheEntry).expireAt)
+}
+
+func (l *LocalCache) removePrefix(prefix string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, elem := range l.items {
+		if strings.HasPrefix(key, prefix) {
+			l.removeElement(elem)
+		}
+	}
+}
+
+func (l *LocalCache) remove(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if elem, ok := l.items[key]; ok {
+		l.removeElement(elem)
+	}
+}
+
+func (l *LocalCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*localCacheEntry)
+	l.ll.Remove(elem)
+	delete(l.items, entry.key)
+	l.bytes -= entry.size
+}
@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	rolePrimary = "primary"
+	roleReplica = "replica"
+
+	// 客户端传入的、不确定存在的数据库或表名统一记为 other，避免标签取值无限增长
+	otherLabel = "other"
+
+	sqlStartKey = "datahub:metrics:start"
+)
+
+var (
+	sqlDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "datahub_sql_duration_seconds",
+		Help:    "SQL execution time by database, role, operation and table.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"db", "role", "operation", "table"})
+	sqlRows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "datahub_sql_rows_total",
+		Help: "Rows returned by queries or affected by writes.",
+	}, []string{"db", "role", "operation", "table"})
+	sqlErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "datahub_sql_errors_total",
+		Help: "SQL statements that returned an error.",
+	}, []string{"db", "role", "operation", "table"})
+
+	redisCommands = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "datahub_redis_commands_total",
+		Help: "Redis commands by db, command and result (ok, nil or error).",
+	}, []string{"db", "command", "result"})
+	redisDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "datahub_redis_command_duration_seconds",
+		Help:    "Redis command latency by db and command.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"db", "command"})
+
+	poolLabels = []string{"db", "role", "replica"}
+
+	poolMaxOpenDesc = prometheus.NewDesc("datahub_db_pool_max_open_connections",
+		"Maximum number of open connections of the pool.", poolLabels, nil)
+	poolOpenDesc = prometheus.NewDesc("datahub_db_pool_open_connections",
+		"Established connections, both in use and idle.", poolLabels, nil)
+	poolInUseDesc = prometheus.NewDesc("datahub_db_pool_in_use_connections",
+		"Connections currently in use.", poolLabels, nil)
+	poolIdleDesc = prometheus.NewDesc("datahub_db_pool_idle_connections",
+		"Idle connections.", poolLabels, nil)
+	poolWaitCountDesc = prometheus.NewDesc("datahub_db_pool_wait_count_total",
+		"Connections waited for because the pool was exhausted.", poolLabels, nil)
+	poolWaitDurationDesc = prometheus.NewDesc("datahub_db_pool_wait_duration_seconds_total",
+		"Time spent waiting for a connection.", poolLabels, nil)
+	poolClosedDesc = prometheus.NewDesc("datahub_db_pool_closed_connections_total",
+		"Connections closed by the pool, by reason (max_idle, max_idle_time, max_lifetime).", append(poolLabels, "reason"), nil)
+	poolHealthyDesc = prometheus.NewDesc("datahub_db_healthy",
+		"1 if the primary's circuit breaker is not open or the replica passes health checks.", poolLabels, nil)
+	dbInFlightDesc = prometheus.NewDesc("datahub_db_in_flight_requests",
+		"Requests currently holding a concurrency slot of the database.", []string{"db"}, nil)
+	txOpenDesc = prometheus.NewDesc("datahub_transactions_open",
+		"Open transactions by database.", []string{"db"}, nil)
+	txOldestAgeDesc = prometheus.NewDesc("datahub_transaction_oldest_age_seconds",
+		"Age of the oldest open transaction by database.", []string{"db"}, nil)
+)
+
+func init() {
+	prometheus.MustRegister(sqlDuration, sqlRows, sqlErrors, redisCommands, redisDuration)
+}
+
+// sqlMetrics 是记录 SQL 耗时和行数的 gorm 插件
+type sqlMetrics struct {
+	db   string
+	role string
+}
+
+func (p *sqlMetrics) Name() string {
+	return "datahub:metrics"
+}
+
+func (p *sqlMetrics) Initialize(db *gorm.DB) error {
+	return registerCallbacks(db, "datahub:metrics", p.before, p.after)
+}
+
+func (p *sqlMetrics) before(db *gorm.DB) {
+	db.InstanceSet(sqlStartKey, time.Now())
+}
+
+func (p *sqlMetrics) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(sqlStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+		// 出错的语句中的表名可能是客户端任意传入的，不作为标签
+		table := db.Statement.Table
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) && table != "" {
+			table = otherLabel
+		}
+		labels := []string{p.db, p.role, operation, table}
+		sqlDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			sqlErrors.WithLabelValues(labels...).Inc()
+			return
+		}
+		if db.RowsAffected > 0 {
+			sqlRows.WithLabelValues(labels...).Add(float64(db.RowsAffected))
+		}
+	}
+}
+
+// redisMetricsHook 记录 redis 命令的次数和耗时
+type redisMetricsHook struct {
+	db string
+}
+
+func newRedisMetricsHook(db int32) redisMetricsHook {
+	return redisMetricsHook{db: strconv.Itoa(int(db))}
+}
+
+func (h redisMetricsHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h redisMetricsHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		h.observe(cmd.Name(), start, err)
+		return err
+	}
+}
+
+func (h redisMetricsHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		h.observe("pipeline", start, err)
+		return err
+	}
+}
+
+func (h redisMetricsHook) observe(command string, start time.Time, err error) {
+	result := "ok"
+	switch {
+	case errors.Is(err, redis.Nil):
+		result = "nil"
+	case err != nil:
+		result = "error"
+	}
+	redisCommands.WithLabelValues(h.db, command, result).Inc()
+	redisDuration.WithLabelValues(h.db, command).Observe(time.Since(start).Seconds())
+}
+
+// dataCollector 在抓取时导出连接池、熔断和事务的状态
+type dataCollector struct {
+	d *Data
+}
+
+func (c *dataCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolMaxOpenDesc
+	ch <- poolOpenDesc
+	ch <- poolInUseDesc
+	ch <- poolIdleDesc
+	ch <- poolWaitCountDesc
+	ch <- poolWaitDurationDesc
+	ch <- poolClosedDesc
+	ch <- poolHealthyDesc
+	ch <- dbInFlightDesc
+	ch <- txOpenDesc
+	ch <- txOldestAgeDesc
+}
+
+func (c *dataCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.d.PoolStats("") {
+		replica := ""
+		if s.Role == roleReplica {
+			replica = strconv.Itoa(int(s.ReplicaIndex))
+		}
+		labels := []string{s.DbName, s.Role, replica}
+		ch <- prometheus.MustNewConstMetric(poolMaxOpenDesc, prometheus.GaugeValue, float64(s.MaxOpenConnections), labels...)
+		ch <- prometheus.MustNewConstMetric(poolOpenDesc, prometheus.GaugeValue, float64(s.OpenConnections), labels...)
+		ch <- prometheus.MustNewConstMetric(poolInUseDesc, prometheus.GaugeValue, float64(s.InUse), labels...)
+		ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(s.Idle), labels...)
+		ch <- prometheus.MustNewConstMetric(poolWaitCountDesc, prometheus.CounterValue, float64(s.WaitCount), labels...)
+		ch <- prometheus.MustNewConstMetric(poolWaitDurationDesc, prometheus.CounterValue, float64(s.WaitDurationMs)/1000, labels...)
+		ch <- prometheus.MustNewConstMetric(poolClosedDesc, prometheus.CounterValue, float64(s.MaxIdleClosed), append(labels, "max_idle")...)
+		ch <- prometheus.MustNewConstMetric(poolClosedDesc, prometheus.CounterValue, float64(s.MaxIdleTimeClosed), append(labels, "max_idle_time")...)
+		ch <- prometheus.MustNewConstMetric(poolClosedDesc, prometheus.CounterValue, float64(s.MaxLifetimeClosed), append(labels, "max_lifetime")...)
+		ch <- prometheus.MustNewConstMetric(poolHealthyDesc, prometheus.GaugeValue, boolValue(s.Healthy), labels...)
+		if s.Role == rolePrimary {
+			ch <- prometheus.MustNewConstMetric(dbInFlightDesc, prometheus.GaugeValue, float64(s.InFlightRequests), s.DbName)
+		}
+	}
+
+	now := time.Now()
+	open := make(map[string]int)
+	oldest := make(map[string]time.Duration)
+	c.d.txMu.RLock()
+	for _, t := range c.d.transactions {
+		open[t.dbName]++
+		oldest[t.dbName] = max(oldest[t.dbName], now.Sub(t.startedAt))
+	}
+	c.d.txMu.RUnlock()
+	for db, n := range open {
+		ch <- prometheus.MustNewConstMetric(txOpenDesc, prometheus.GaugeValue, float64(n), db)
+		ch <- prometheus.MustNewConstMetric(txOldestAgeDesc, prometheus.GaugeValue, oldest[db].Seconds(), db)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
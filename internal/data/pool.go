@@ -0,0 +1,161 @@
+package data
+
+import (
+	"database/sql"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+)
+
+const (
+	defaultMaxOpenConns    = 100
+	defaultMaxIdleConns    = 10
+	defaultConnMaxLifetime = 30 * time.Minute
+	// 低于常见代理和负载均衡的空闲超时，避免拿到已被断开的连接
+	defaultConnMaxIdleTime = 5 * time.Minute
+)
+
+func configurePool(db *sql.DB, c *conf.Data_Database) {
+	maxOpen := int(c.MaxOpenConns)
+	if maxOpen <= 0 {
+		maxOpen = defaultMaxOpenConns
+	}
+	maxIdle := int(c.MaxIdleConns)
+	if maxIdle <= 0 {
+		maxIdle = defaultMaxIdleConns
+	}
+	lifetime := c.ConnMaxLifetime.AsDuration()
+	if lifetime <= 0 {
+		lifetime = defaultConnMaxLifetime
+	}
+	idleTime := c.ConnMaxIdleTime.AsDuration()
+	if idleTime <= 0 {
+		idleTime = defaultConnMaxIdleTime
+	}
+
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(min(maxIdle, maxOpen))
+	db.SetConnMaxLifetime(lifetime)
+	db.SetConnMaxIdleTime(idleTime)
+
+	// 内存数据库只有一个连接，连接关闭后数据随之丢失，不能因为空闲或到期被回收
+	if isSQLite(c.Driver) && isSQLiteMemory(c.Dsn) {
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxLifetime(0)
+		db.SetConnMaxIdleTime(0)
+	}
+}
+
+// 把配置的超时写入 dsn，dsn 中已经指定的参数优先
+func applyDSNTimeouts(c *conf.Data_Database, dsn string) (string, error) {
+	connect := c.ConnectTimeout.AsDuration()
+	read := c.ReadTimeout.AsDuration()
+	write := c.WriteTimeout.AsDuration()
+	if connect <= 0 && read <= 0 && write <= 0 {
+		return dsn, nil
+	}
+
+	switch strings.ToLower(c.Driver) {
+	case "", driverMySQL:
+		cfg, err := mysqlDriver.ParseDSN(dsn)
+		if err != nil {
+			return "", fmt.Errorf("parse mysql dsn of database %s: %w", c.Name, err)
+		}
+		if cfg.Timeout == 0 && connect > 0 {
+			cfg.Timeout = connect
+		}
+		if cfg.ReadTimeout == 0 && read > 0 {
+			cfg.ReadTimeout = read
+		}
+		if cfg.WriteTimeout == 0 && write > 0 {
+			cfg.WriteTimeout = write
+		}
+		return cfg.FormatDSN(), nil
+
+	case driverPostgres, "postgresql", "pgx":
+		if connect <= 0 || strings.Contains(dsn, "connect_timeout") {
+			return dsn, nil
+		}
+		seconds := max(int(connect/time.Second), 1)
+		if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+			u, err := url.Parse(dsn)
+			if err != nil {
+				return "", fmt.Errorf("parse postgres dsn of database %s: %w", c.Name, err)
+			}
+			q := u.Query()
+			q.Set("connect_timeout", fmt.Sprint(seconds))
+			u.RawQuery = q.Encode()
+			return u.String(), nil
+		}
+		return fmt.Sprintf("%s connect_timeout=%d", dsn, seconds), nil
+
+	default:
+		return dsn, nil
+	}
+}
+
+// PoolStats 返回主库和副本的连接池状态，dbName 为空时返回全部数据库
+func (d *Data) PoolStats(dbName string) []*v1.PoolStats {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+
+	names := make([]string, 0, len(d.db))
+	for name := range d.db {
+		if dbName == "" || name == dbName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	stats := make([]*v1.PoolStats, 0, len(names))
+	for _, name := range names {
+		if sqlDB, err := d.db[name].DB(); err == nil {
+			s := newPoolStats(name, sqlDB.Stats())
+			s.Role = rolePrimary
+			s.Healthy = true
+			if g := d.guards[name]; g != nil {
+				s.BreakerState = g.state()
+				s.Healthy = s.BreakerState != breakerOpen.String()
+				s.InFlightRequests = g.inFlight.Load()
+				s.MaxConcurrentRequests = int32(g.maxConcurrent())
+			}
+			stats = append(stats, s)
+		}
+		if set := d.replicas[name]; set != nil {
+			for _, rep := range set.replicas {
+				sqlDB, err := rep.db.DB()
+				if err != nil {
+					continue
+				}
+				s := newPoolStats(name, sqlDB.Stats())
+				s.Role = roleReplica
+				s.ReplicaIndex = int32(rep.index)
+				s.Healthy = rep.healthy.Load()
+				stats = append(stats, s)
+			}
+		}
+	}
+	return stats
+}
+
+func newPoolStats(dbName string, s sql.DBStats) *v1.PoolStats {
+	return &v1.PoolStats{
+		DbName:             dbName,
+		MaxOpenConnections: int32(s.MaxOpenConnections),
+		OpenConnections:    int32(s.OpenConnections),
+		InUse:              int32(s.InUse),
+		Idle:               int32(s.Idle),
+		WaitCount:          s.WaitCount,
+		WaitDurationMs:     s.WaitDuration.Milliseconds(),
+		MaxIdleClosed:      s.MaxIdleClosed,
+		MaxIdleTimeClosed:  s.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  s.MaxLifetimeClosed,
+	}
+}
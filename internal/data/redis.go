@@ -0,0 +1,352 @@
+package data
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	zaplog "datahub/internal/log"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisModeStandalone = "standalone"
+	redisModeSentinel   = "sentinel"
+	redisModeCluster    = "cluster"
+
+	redisPingTimeout = 5 * time.Second
+
+	// 默认的 16 个 db 中的最后一个，与按 RedisDB 枚举生成的命名空间（0-5）错开
+	defaultRedisStateDb = 15
+)
+
+type RedisClient struct {
+	clients    map[int32]redis.UniversalClient // 键是 redis db 编号，cluster 模式只有 0
+	namespaces map[string]*CacheNamespace      // 键是命名空间名称
+	stateDb    int32
+	state      redis.UniversalClient // 保存 binlog 位点、幂等记录等非缓存数据
+}
+
+// CacheNamespace 是配置中定义的缓存命名空间
+type CacheNamespace struct {
+	Name      string
+	DB        int32
+	TTL       time.Duration
+	KeyPrefix string
+	Client    redis.UniversalClient
+}
+
+type redisLogger struct {
+	*log.Helper
+}
+
+func (r *redisLogger) Printf(_ context.Context, format string, args ...interface{}) {
+	r.Debugf(format, args...)
+}
+
+func NewRedisClients(c *conf.Data, logger log.Logger) (*RedisClient, error) {
+	// 是否输出由 redis 这个 logger 的级别决定
+	redis.SetLogger(&redisLogger{log.NewHelper(log.With(logger, zaplog.LoggerKey, "redis"))})
+
+	mode, err := redisMode(c.Redis)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := newRedisTLSConfig(c.Redis.Tls)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb := &RedisClient{
+		clients:    make(map[int32]redis.UniversalClient),
+		namespaces: make(map[string]*CacheNamespace),
+	}
+
+	// 每个命名空间复用同一个 db 的客户端
+	for _, ns := range redisNamespaces(c.Redis, mode) {
+		if ns.Name == "" {
+			rdb.Close()
+			return nil, fmt.Errorf("redis namespace name is required")
+		}
+		if _, ok := rdb.namespaces[ns.Name]; ok {
+			rdb.Close()
+			return nil, fmt.Errorf("duplicate redis namespace %s", ns.Name)
+		}
+		if mode == redisModeCluster && ns.Db != 0 {
+			rdb.Close()
+			return nil, fmt.Errorf("redis cluster only supports db 0, use key_prefix to separate namespace %s", ns.Name)
+		}
+
+		client, err := rdb.connect(c.Redis, mode, tlsConfig, ns.Db, logger)
+		if err != nil {
+			rdb.Close()
+			return nil, err
+		}
+
+		ttl := ns.Ttl.AsDuration()
+		if ttl <= 0 {
+			ttl = defaultCacheTTL
+		}
+		rdb.namespaces[ns.Name] = &CacheNamespace{
+			Name:      ns.Name,
+			DB:        ns.Db,
+			TTL:       ttl,
+			KeyPrefix: ns.KeyPrefix,
+			Client:    client,
+		}
+	}
+
+	// 非缓存数据单独使用一个 db，是否与命名空间冲突由 stateClient 检查
+	rdb.stateDb = defaultRedisStateDb
+	if mode == redisModeCluster {
+		rdb.stateDb = 0
+	}
+	if c.Redis.StateDb != nil {
+		rdb.stateDb = c.Redis.GetStateDb()
+	}
+	if mode == redisModeCluster && rdb.stateDb != 0 {
+		rdb.Close()
+		return nil, fmt.Errorf("redis cluster only supports db 0, state_db must be 0")
+	}
+	if rdb.state, err = rdb.connect(c.Redis, mode, tlsConfig, rdb.stateDb, logger); err != nil {
+		rdb.Close()
+		return nil, err
+	}
+
+	return rdb, nil
+}
+
+// connect 返回 db 的客户端，同一个 db 只创建一次
+func (r *RedisClient) connect(c *conf.Data_Redis, mode string, tlsConfig *tls.Config, db int32, logger log.Logger) (redis.UniversalClient, error) {
+	if client, ok := r.clients[db]; ok {
+		return client, nil
+	}
+	client := newRedisClient(c, mode, tlsConfig, int(db))
+	client.AddHook(newRedisMetricsHook(db))
+	client.AddHook(newRedisTracingHook(db))
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisPingTimeout)
+	err := client.Ping(ctx).Err()
+	cancel()
+	if err != nil {
+		log.NewHelper(logger).Errorf("connect to redis db %d (%s) error: %v", db, mode, err)
+		_ = client.Close()
+		return nil, err
+	}
+
+	r.clients[db] = client
+	return client, nil
+}
+
+func redisMode(c *conf.Data_Redis) (string, error) {
+	mode := strings.ToLower(c.Mode)
+	if mode == "" {
+		if c.Master != "" {
+			mode = redisModeSentinel
+		} else {
+			mode = redisModeStandalone
+		}
+	}
+
+	switch mode {
+	case redisModeStandalone, redisModeCluster:
+		if len(c.Addrs) == 0 {
+			return "", fmt.Errorf("redis addrs are required in %s mode", mode)
+		}
+	case redisModeSentinel:
+		if c.Master == "" {
+			return "", fmt.Errorf("redis master is required in sentinel mode")
+		}
+		if len(c.SentinelAddrs) == 0 && len(c.Addrs) == 0 {
+			return "", fmt.Errorf("redis sentinelAddrs are required in sentinel mode")
+		}
+	default:
+		return "", fmt.Errorf("unsupported redis mode: %s", c.Mode)
+	}
+	return mode, nil
+}
+
+func newRedisClient(c *conf.Data_Redis, mode string, tlsConfig *tls.Config, db int) redis.UniversalClient {
+	switch mode {
+	case redisModeCluster:
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        c.Addrs,
+			Username:     c.Username,
+			Password:     c.Password,
+			TLSConfig:    tlsConfig,
+			PoolSize:     int(c.PoolSize),
+			MinIdleConns: int(c.MinIdleConns),
+			DialTimeout:  c.DialTimeout.AsDuration(),
+			ReadTimeout:  c.ReadTimeout.AsDuration(),
+			WriteTimeout: c.WriteTimeout.AsDuration(),
+			PoolTimeout:  c.PoolTimeout.AsDuration(),
+		})
+	case redisModeSentinel:
+		sentinelAddrs := c.SentinelAddrs
+		if len(sentinelAddrs) == 0 {
+			sentinelAddrs = c.Addrs
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       c.Master,
+			SentinelAddrs:    sentinelAddrs,
+			SentinelUsername: c.SentinelUsername,
+			SentinelPassword: c.SentinelPassword,
+			Username:         c.Username,
+			Password:         c.Password,
+			DB:               db,
+			TLSConfig:        tlsConfig,
+			PoolSize:         int(c.PoolSize),
+			MinIdleConns:     int(c.MinIdleConns),
+			DialTimeout:      c.DialTimeout.AsDuration(),
+			ReadTimeout:      c.ReadTimeout.AsDuration(),
+			WriteTimeout:     c.WriteTimeout.AsDuration(),
+			PoolTimeout:      c.PoolTimeout.AsDuration(),
+		})
+	default:
+		return redis.NewClient(&redis.Options{
+			Addr:         c.Addrs[0],
+			Username:     c.Username,
+			Password:     c.Password,
+			DB:           db,
+			TLSConfig:    tlsConfig,
+			PoolSize:     int(c.PoolSize),
+			MinIdleConns: int(c.MinIdleConns),
+			DialTimeout:  c.DialTimeout.AsDuration(),
+			ReadTimeout:  c.ReadTimeout.AsDuration(),
+			WriteTimeout: c.WriteTimeout.AsDuration(),
+			PoolTimeout:  c.PoolTimeout.AsDuration(),
+		})
+	}
+}
+
+func newRedisTLSConfig(c *conf.Data_Redis_TLS) (*tls.Config, error) {
+	if c == nil || !c.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CaFile != "" {
+		caCert, err := os.ReadFile(c.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read redis ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("invalid redis ca file %s", c.CaFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load redis client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// 未配置命名空间时，按 RedisDB 枚举生成，与原来的 db 映射保持一致；
+// cluster 模式只有 db 0，改用命名空间名称作为 key 前缀区分
+func redisNamespaces(c *conf.Data_Redis, mode string) []*conf.Data_Redis_Namespace {
+	if len(c.Namespaces) > 0 {
+		return c.Namespaces
+	}
+	namespaces := make([]*conf.Data_Redis_Namespace, 0, len(v1.RedisDB_name))
+	for dbNum := range v1.RedisDB_name {
+		if dbNum == int32(v1.RedisDB_UNSPECIFIED) {
+			continue
+		}
+		name := legacyNamespaceName(v1.RedisDB(dbNum))
+		ns := &conf.Data_Redis_Namespace{
+			Name: name,
+			Db:   dbNum - 1, //redis的db从0开始
+		}
+		if mode == redisModeCluster {
+			ns.Db = 0
+			ns.KeyPrefix = name + ":"
+		}
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+func legacyNamespaceName(db v1.RedisDB) string {
+	return strings.ToLower(db.String())
+}
+
+// GetNamespace 按名称返回缓存命名空间；名称为空时按已废弃的 RedisDB 枚举查找
+func (r *RedisClient) GetNamespace(name string, legacy v1.RedisDB) *CacheNamespace {
+	if name == "" {
+		if legacy <= v1.RedisDB_UNSPECIFIED {
+			return nil
+		}
+		name = legacyNamespaceName(legacy)
+	}
+	return r.namespaces[name]
+}
+
+// stateClient 返回保存 key 的客户端，key 为非缓存数据的 key 或 key 前缀。
+// state db 中有没有 key_prefix 的命名空间，或 key 与命名空间的前缀重叠时返回错误，
+// 否则清空该命名空间的缓存时会把这些数据一起删除
+func (r *RedisClient) stateClient(key string) (redis.UniversalClient, error) {
+	for _, ns := range r.namespaces {
+		if ns.DB != r.stateDb {
+			continue
+		}
+		if ns.KeyPrefix == "" {
+			return nil, fmt.Errorf("redis state db %d is shared with cache namespace %s that has no key_prefix, set redis.state_db to another db", r.stateDb, ns.Name)
+		}
+		if strings.HasPrefix(key, ns.KeyPrefix) || strings.HasPrefix(ns.KeyPrefix, key) {
+			return nil, fmt.Errorf("redis key %s overlaps key_prefix %s of cache namespace %s", key, ns.KeyPrefix, ns.Name)
+		}
+	}
+	return r.state, nil
+}
+
+// ownsDB 判断命名空间是否独占一个 db：没有其他命名空间和非缓存数据使用该 db，cluster 模式始终为 false
+func (r *RedisClient) ownsDB(ns *CacheNamespace) bool {
+	if _, ok := ns.Client.(*redis.ClusterClient); ok {
+		return false
+	}
+	if ns.DB == r.stateDb {
+		return false
+	}
+	for _, other := range r.namespaces {
+		if other.Name != ns.Name && other.DB == ns.DB {
+			return false
+		}
+	}
+	return true
+}
+
+// pubSubClient 返回用于 pub/sub 的客户端，频道与 db 无关，取编号最小的即可
+func (r *RedisClient) pubSubClient() redis.UniversalClient {
+	var (
+		client redis.UniversalClient
+		minNum int32
+	)
+	for num, c := range r.clients {
+		if client == nil || num < minNum {
+			client, minNum = c, num
+		}
+	}
+	return client
+}
+
+func (r *RedisClient) Close() {
+	for _, client := range r.clients {
+		_ = client.Close()
+	}
+}
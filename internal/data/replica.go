@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/conf"
+	zaplog "datahub/internal/log"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReplicaHealthInterval = 5 * time.Second
+	replicaPingTimeout           = 2 * time.Second
+)
+
+// ReplicaSet 是一个数据库的只读副本，按轮询选择健康的副本，全部不可用时由调用方回退到主库
+type ReplicaSet struct {
+	name     string
+	replicas []*replica
+	next     atomic.Uint64
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type replica struct {
+	index   int
+	db      *gorm.DB
+	healthy atomic.Bool
+}
+
+// NewReplicas 打开所有配置了副本的数据库，副本由 Data 负责关闭
+func NewReplicas(c *conf.Data, levels *zaplog.Levels, logger log.Logger, slow *SlowQueryLog) (map[string]*ReplicaSet, error) {
+	sets := make(map[string]*ReplicaSet)
+	for _, source := range c.Databases {
+		if len(source.ReplicaDsns) == 0 {
+			continue
+		}
+		set, err := newReplicaSet(source, levels, logger, slow)
+		if err != nil {
+			for _, set := range sets {
+				set.Close()
+			}
+			return nil, err
+		}
+		sets[source.Name] = set
+	}
+	return sets, nil
+}
+
+// 打开副本并启动健康检查，副本启动时不可用不影响服务启动，由健康检查恢复
+func newReplicaSet(source *conf.Data_Database, levels *zaplog.Levels, logger log.Logger, slow *SlowQueryLog) (*ReplicaSet, error) {
+	set := &ReplicaSet{name: source.Name, log: log.NewHelper(logger)}
+	for i, dsn := range source.ReplicaDsns {
+		db, err := openDatabase(source, dsn, roleReplica, levels, logger, slow, &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			set.closeDBs()
+			log.NewHelper(logger).Errorf("open replica %d of database %s error: %v", i, source.Name, err)
+			return nil, err
+		}
+		set.replicas = append(set.replicas, &replica{index: i, db: db})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	set.cancel, set.done = cancel, make(chan struct{})
+	set.check(ctx)
+
+	interval := source.ReplicaHealthInterval.AsDuration()
+	if interval <= 0 {
+		interval = defaultReplicaHealthInterval
+	}
+	go func() {
+		defer close(set.done)
+		set.run(ctx, interval)
+	}()
+	return set, nil
+}
+
+// Close 停止健康检查并关闭所有副本连接
+func (s *ReplicaSet) Close() {
+	if s == nil {
+		return
+	}
+	if s.cancel != nil {
+		s.cancel()
+		<-s.done
+	}
+	s.closeDBs()
+}
+
+func (s *ReplicaSet) closeDBs() {
+	for _, rep := range s.replicas {
+		if sqlDB, err := rep.db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
+
+// Pick 轮询返回一个健康的副本，没有可用副本时返回 nil
+func (s *ReplicaSet) Pick() *gorm.DB {
+	if s == nil || len(s.replicas) == 0 {
+		return nil
+	}
+	n := uint64(len(s.replicas))
+	start := s.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		rep := s.replicas[(start+i)%n]
+		if rep.healthy.Load() {
+			return rep.db
+		}
+	}
+	return nil
+}
+
+// ReportError 请求在副本上出现连接错误时立即摘除，等待健康检查恢复
+func (s *ReplicaSet) ReportError(db *gorm.DB, err error) {
+	if s == nil || !IsConnError(err) {
+		return
+	}
+	for _, rep := range s.replicas {
+		if rep.db == db && rep.healthy.CompareAndSwap(true, false) {
+			s.log.Warnf("replica %d of database %s marked unhealthy: %v", rep.index, s.name, err)
+		}
+	}
+}
+
+func (s *ReplicaSet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+func (s *ReplicaSet) check(ctx context.Context) {
+	for _, rep := range s.replicas {
+		err := rep.ping(ctx)
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			s.log.Infof("replica %d of database %s is healthy", rep.index, s.name)
+		} else {
+			s.log.Warnf("replica %d of database %s is unhealthy: %v", rep.index, s.name, err)
+		}
+	}
+}
+
+func (r *replica) ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// readDB 返回非事务读请求使用的连接：优先健康的副本，STRONG 或没有副本时使用主库。
+// 第二个返回值表示是否选中了副本，用完后必须调用返回的 release
+func (d *Data) readDB(dbName string, consistency v1.Consistency) (*gorm.DB, bool, func(), error) {
+	d.dbMu.RLock()
+	defer d.dbMu.RUnlock()
+	if consistency != v1.Consistency_STRONG {
+		if db := d.replicas[dbName].Pick(); db != nil {
+			return db, true, d.hold(db), nil
+		}
+	}
+	db, ok := d.db[dbName]
+	if !ok {
+		return nil, false, nil, errDatabaseNotFound(dbName)
+	}
+	return db, false, d.hold(db), nil
+}
+
+var (
+	// 需要在主库执行的 SELECT：加锁、写入变量或文件
+	lockingSelectPattern = regexp.MustCompile(`(?is)\bfor\s+(update|share|no\s+key\s+update|key\s+share)\b|\block\s+in\s+share\s+mode\b|\binto\b`)
+	// 有副作用的函数：用户锁、序列等
+	sideEffectFuncPattern = regexp.MustCompile(`(?i)\b(get_lock|release_lock|release_all_locks|pg_(try_)?advisory_(xact_)?lock(_shared)?|pg_advisory_unlock(_shared|_all)?|nextval|setval|lastval|sleep|pg_sleep)\s*\(`)
+	explainAnalyzePattern = regexp.MustCompile(`(?i)\banalyze\b`)
+)
+
+// isReadOnlySQL 判断原生 SQL 是否为只读语句，只读语句可以发往副本。无法确定时按写语句处理
+func isReadOnlySQL(sql string) bool {
+	s := strings.TrimLeft(sql, " \t\r\n(")
+	// 多条语句只要有一条不是只读就不能发往副本，统一按写语句处理
+	if strings.Contains(strings.TrimRight(s, " \t\r\n;"), ";") {
+		return false
+	}
+	if sideEffectFuncPattern.MatchString(s) {
+		return false
+	}
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == '('
+	})
+	if end < 0 {
+		end = len(s)
+	}
+	switch strings.ToLower(s[:end]) {
+	case "select":
+		// SELECT ... FOR UPDATE / FOR SHARE 需要在主库加锁
+		return !lockingSelectPattern.MatchString(s)
+	case "show", "describe", "desc":
+		return true
+	case "explain":
+		// postgres 的 EXPLAIN ANALYZE 会真正执行语句
+		return !explainAnalyzePattern.MatchString(s)
+	}
+	return false
+}
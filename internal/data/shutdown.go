@@ -0,0 +1,103 @@
+package data
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	defaultTransactionGracePeriod = 30 * time.Second
+	transactionDrainPollInterval  = 100 * time.Millisecond
+)
+
+func errShuttingDown() error {
+	return errors.ServiceUnavailable(v1.ReasonUnavailable, "server is shutting down, new transactions are not accepted")
+}
+
+// DrainTransactions 在服务停止前调用：不再接受新的事务，等待未完成的事务在宽限期内提交或回滚，
+// 超时后回滚剩余的事务。期间 gRPC 服务仍在运行，事务内的请求和提交照常处理
+func (d *Data) DrainTransactions(ctx context.Context) error {
+	// 收到信号时 kratos 和 main 都会调用 Stop，后来的调用等待第一次完成
+	var err error
+	d.drainOnce.Do(func() {
+		err = d.drainTransactions(ctx)
+	})
+	return err
+}
+
+func (d *Data) drainTransactions(ctx context.Context) error {
+	d.draining.Store(true)
+	for _, fn := range d.drainHooks {
+		fn()
+	}
+	open := d.transactionCount()
+	if open == 0 {
+		return nil
+	}
+	d.log.Infof("draining %d open transactions, grace period %s", open, d.gracePeriod)
+
+	timer := time.NewTimer(d.gracePeriod)
+	defer timer.Stop()
+	ticker := time.NewTicker(transactionDrainPollInterval)
+	defer ticker.Stop()
+	for d.transactionCount() > 0 {
+		select {
+		case <-ctx.Done():
+			d.rollbackTransactions()
+			return ctx.Err()
+		case <-timer.C:
+			d.rollbackTransactions()
+			return nil
+		case <-ticker.C:
+		}
+	}
+	d.log.Info("all open transactions finished")
+	return nil
+}
+
+// onDrain 注册进入停止流程时的回调，只能在启动阶段调用
+func (d *Data) onDrain(fn func()) {
+	d.drainHooks = append(d.drainHooks, fn)
+}
+
+func (d *Data) transactionCount() int {
+	d.txMu.RLock()
+	defer d.txMu.RUnlock()
+	return len(d.transactions)
+}
+
+// rollbackTransactions 按开始时间从早到晚回滚剩余的事务，最早的事务持有锁的时间最长，先释放
+func (d *Data) rollbackTransactions() {
+	d.txMu.Lock()
+	ids := make([]string, 0, len(d.transactions))
+	for id := range d.transactions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return d.transactions[ids[i]].startedAt.Before(d.transactions[ids[j]].startedAt)
+	})
+	pending := make([]*openTransaction, len(ids))
+	for i, id := range ids {
+		pending[i] = d.transactions[id]
+		delete(d.transactions, id)
+	}
+	d.txMu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	d.log.Warnf("rolling back %d unfinished transactions", len(pending))
+	for i, t := range pending {
+		err := t.tx.Rollback().Error
+		t.release()
+		if err != nil {
+			d.log.Errorf("rollback transaction %s on database %s error: %v", ids[i], t.dbName, err)
+			continue
+		}
+		d.log.Infof("rolled back transaction %s on database %s, open for %s", ids[i], t.dbName, time.Since(t.startedAt).Round(time.Millisecond))
+	}
+}
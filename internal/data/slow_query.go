@@ -0,0 +1,285 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"datahub/internal/conf"
+	zaplog "datahub/internal/log"
+	"datahub/pkg/md"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSlowQueryThreshold = time.Second
+	defaultExplainTimeout     = 5 * time.Second
+	slowQueryFileName         = "datahub-slow.log"
+	slowQueryPluginName       = "datahub:slow_query"
+	slowQueryStartKey         = "datahub:slow_query:start"
+
+	// 同时执行的 EXPLAIN 数量上限，慢查询集中出现时跳过多余的 EXPLAIN
+	maxConcurrentExplains = 2
+)
+
+var (
+	sqlCommentPattern    = regexp.MustCompile(`(?s)/\*.*?\*/|--[^\n]*`)
+	sqlStringPattern     = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumberPattern     = regexp.MustCompile(`\$\d+|\b\d+(?:\.\d+)?\b`)
+	sqlPlaceholderList   = regexp.MustCompile(`\?(?:\s*,\s*\?)+`)
+	sqlValuesList        = regexp.MustCompile(`\(\s*\?\+?\s*\)(?:\s*,\s*\(\s*\?\+?\s*\))+`)
+	sqlWhitespacePattern = regexp.MustCompile(`\s+`)
+	sqlSelectPattern     = regexp.MustCompile(`(?i)^\s*(select|with)\b`)
+
+	// 查找调用位置时跳过本文件中的回调
+	slowQuerySourceFile string
+)
+
+func init() {
+	_, slowQuerySourceFile, _, _ = runtime.Caller(0)
+}
+
+// SlowQueryLog 把超过阈值的 SQL 写入单独的日志文件，记录请求 ID、调用位置、数据库、
+// 归一化后的 SQL 指纹、耗时和行数。SQL 中的参数值不会写入日志
+type SlowQueryLog struct {
+	threshold      time.Duration
+	explain        bool
+	explainTimeout time.Duration
+	explainSlots   chan struct{}
+	log            log.Logger
+}
+
+func NewSlowQueryLog(c *conf.Data, l *conf.Log, logger log.Logger) (*SlowQueryLog, func(), error) {
+	sc := c.SlowQuery
+	s := &SlowQueryLog{
+		threshold:      sc.GetThreshold().AsDuration(),
+		explain:        sc.GetExplain(),
+		explainTimeout: sc.GetExplainTimeout().AsDuration(),
+		explainSlots:   make(chan struct{}, maxConcurrentExplains),
+	}
+	if s.threshold == 0 {
+		s.threshold = defaultSlowQueryThreshold
+	}
+	if s.threshold < 0 {
+		log.NewHelper(logger).Info("slow query log is disabled")
+		return nil, func() {}, nil
+	}
+	if s.explainTimeout <= 0 {
+		s.explainTimeout = defaultExplainTimeout
+	}
+
+	path := sc.GetPath()
+	if path == "" {
+		path = strings.TrimRight(l.Path, "/") + "/" + slowQueryFileName
+	}
+	var closeLog func()
+	s.log, closeLog = zaplog.NewFileLogger(&zaplog.Config{
+		Level:      "info",
+		Filename:   path,
+		MaxSize:    int(l.Size),
+		MaxBackups: int(l.Limit),
+		MaxAge:     int(l.Expire),
+		Compress:   true,
+	})
+	log.NewHelper(logger).Infof("slow query log: threshold %s, file %s, explain %t", s.threshold, path, s.explain)
+
+	cleanup := func() {
+		// 占满所有名额，等待后台的 EXPLAIN 写完日志后再关闭文件
+		for i := 0; i < cap(s.explainSlots); i++ {
+			s.explainSlots <- struct{}{}
+		}
+		closeLog()
+	}
+	return s, cleanup, nil
+}
+
+// plugin 返回记录慢查询的 gorm 插件
+func (s *SlowQueryLog) plugin(dbName, role string) gorm.Plugin {
+	return &slowQueryPlugin{s: s, db: dbName, role: role}
+}
+
+type slowQueryPlugin struct {
+	s    *SlowQueryLog
+	db   string
+	role string
+	root *gorm.DB
+}
+
+func (p *slowQueryPlugin) Name() string {
+	return slowQueryPluginName
+}
+
+func (p *slowQueryPlugin) Initialize(db *gorm.DB) error {
+	p.root = db
+	return registerCallbacks(db, slowQueryPluginName, p.before, p.after)
+}
+
+func (p *slowQueryPlugin) before(db *gorm.DB) {
+	db.InstanceSet(slowQueryStartKey, time.Now())
+}
+
+func (p *slowQueryPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(slowQueryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+		elapsed := time.Since(start)
+		if elapsed < p.s.threshold {
+			return
+		}
+
+		ctx := db.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		sql := db.Statement.SQL.String()
+		fingerprint := sqlFingerprint(sql)
+		keyvals := []interface{}{
+			"msg", "slow query",
+			zaplog.TraceIdKey, md.TraceId(ctx),
+			zaplog.MethodKey, operationOf(ctx),
+			"caller", sqlCaller(),
+			zaplog.DbKey, p.db,
+			"role", p.role,
+			"operation", operation,
+			zaplog.TableKey, db.Statement.Table,
+			"fingerprint", fingerprint,
+			"fingerprint_id", fingerprintID(fingerprint),
+			"duration_ms", elapsed.Milliseconds(),
+			"rows", db.RowsAffected,
+		}
+		if db.Error != nil {
+			keyvals = append(keyvals, "error", db.Error.Error())
+		}
+
+		if !p.s.explain || !sqlSelectPattern.MatchString(sql) {
+			_ = p.s.log.Log(log.LevelWarn, keyvals...)
+			return
+		}
+		select {
+		case p.s.explainSlots <- struct{}{}:
+		default:
+			_ = p.s.log.Log(log.LevelWarn, append(keyvals, "explain_skipped", true)...)
+			return
+		}
+		// 在后台执行 EXPLAIN，不增加请求的耗时；事务中的语句也使用连接池中的连接
+		vars := append([]interface{}(nil), db.Statement.Vars...)
+		go func() {
+			defer func() { <-p.s.explainSlots }()
+			plan, err := p.explainQuery(context.WithoutCancel(ctx), sql, vars)
+			if err != nil {
+				keyvals = append(keyvals, "explain_error", err.Error())
+			} else {
+				keyvals = append(keyvals, "explain", plan)
+			}
+			_ = p.s.log.Log(log.LevelWarn, keyvals...)
+		}()
+	}
+}
+
+// explainQuery 直接在连接池上执行 EXPLAIN，不经过 gorm 的回调，避免被再次记录
+func (p *slowQueryPlugin) explainQuery(ctx context.Context, sql string, vars []interface{}) (string, error) {
+	sqlDB, err := p.root.DB()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.s.explainTimeout)
+	defer cancel()
+
+	prefix := "EXPLAIN "
+	if p.root.Dialector.Name() == driverSQLite {
+		prefix = "EXPLAIN QUERY PLAN "
+	}
+	rows, err := sqlDB.QueryContext(ctx, prefix+sql, vars...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	var plan []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return "", err
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, col := range columns {
+			if b, ok := values[i].([]byte); ok {
+				row[col] = string(b)
+			} else {
+				row[col] = values[i]
+			}
+		}
+		plan = append(plan, row)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// sqlFingerprint 去掉注释和字面量，合并占位符列表、批量插入的多行和空白，相同结构的 SQL 得到相同的指纹
+func sqlFingerprint(sql string) string {
+	sql = sqlCommentPattern.ReplaceAllString(sql, " ")
+	sql = sqlStringPattern.ReplaceAllString(sql, "?")
+	sql = sqlNumberPattern.ReplaceAllString(sql, "?")
+	sql = sqlPlaceholderList.ReplaceAllString(sql, "?+")
+	sql = sqlValuesList.ReplaceAllString(sql, "(?+)+")
+	sql = sqlWhitespacePattern.ReplaceAllString(sql, " ")
+	return strings.ToLower(strings.TrimSpace(sql))
+}
+
+func fingerprintID(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(sum[:8])
+}
+
+// sqlCaller 返回 gorm 和本文件之外的第一个调用位置
+func sqlCaller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if frame.File != slowQuerySourceFile && !strings.Contains(frame.File, "gorm.io/") {
+			return fmt.Sprintf("%s/%s:%d", filepath.Base(filepath.Dir(frame.File)), filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// operationOf 返回当前请求的 gRPC 方法
+func operationOf(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.Operation()
+	}
+	return ""
+}
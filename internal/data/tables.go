@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// 表名列表的最短刷新间隔，查不到的表名在间隔内不会再次查询数据库
+	tableListRefreshInterval = 30 * time.Second
+	tableListTimeout         = 2 * time.Second
+)
+
+// tableList 缓存数据库中的表名，用于把指标和缓存统计中客户端传入的表名限制在实际存在的表内
+type tableList struct {
+	mu       sync.Mutex
+	tables   map[string]struct{}
+	loadedAt time.Time
+}
+
+// HasTable 判断数据库是否已配置且存在该表。表名列表按需从主库加载，查询失败时返回 false
+func (d *Data) HasTable(dbName, table string) bool {
+	if table == "" || !d.HasDatabase(dbName) {
+		return false
+	}
+	v, _ := d.tables.LoadOrStore(dbName, &tableList{})
+	l := v.(*tableList)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.tables[table]; ok {
+		return true
+	}
+	if time.Since(l.loadedAt) < tableListRefreshInterval {
+		return false
+	}
+	l.loadedAt = time.Now()
+
+	db, release, err := d.database(dbName)
+	if err != nil {
+		return false
+	}
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), tableListTimeout)
+	defer cancel()
+	names, err := db.WithContext(ctx).Migrator().GetTables()
+	if err != nil {
+		d.log.Warnf("list tables of database %s error: %v", dbName, err)
+		return false
+	}
+	l.tables = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		l.tables[name] = struct{}{}
+	}
+	_, ok := l.tables[table]
+	return ok
+}
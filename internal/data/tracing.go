@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const sqlSpanKey = "datahub:tracing:span"
+
+// 全局 TracerProvider 在启动时设置，这里拿到的 tracer 会自动使用它
+var tracer = otel.Tracer("datahub/internal/data")
+
+// registerCallbacks 在 gorm 的每类操作前后注册回调，after 的参数是操作名称
+func registerCallbacks(db *gorm.DB, prefix string, before func(*gorm.DB), after func(operation string) func(*gorm.DB)) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register(prefix+":before_create", before),
+		cb.Create().After("gorm:create").Register(prefix+":after_create", after("create")),
+		cb.Query().Before("gorm:query").Register(prefix+":before_query", before),
+		cb.Query().After("gorm:query").Register(prefix+":after_query", after("query")),
+		cb.Update().Before("gorm:update").Register(prefix+":before_update", before),
+		cb.Update().After("gorm:update").Register(prefix+":after_update", after("update")),
+		cb.Delete().Before("gorm:delete").Register(prefix+":before_delete", before),
+		cb.Delete().After("gorm:delete").Register(prefix+":after_delete", after("delete")),
+		cb.Row().Before("gorm:row").Register(prefix+":before_row", before),
+		cb.Row().After("gorm:row").Register(prefix+":after_row", after("row")),
+		cb.Raw().Before("gorm:raw").Register(prefix+":before_raw", before),
+		cb.Raw().After("gorm:raw").Register(prefix+":after_raw", after("raw")),
+	)
+}
+
+// sqlTracing 是为每条 SQL 创建 span 的 gorm 插件，父 span 来自 WithContext 传入的上下文
+type sqlTracing struct {
+	db     string
+	role   string
+	system string // 驱动名称，初始化时从 Dialector 获取
+}
+
+func (p *sqlTracing) Name() string {
+	return "datahub:tracing"
+}
+
+func (p *sqlTracing) Initialize(db *gorm.DB) error {
+	p.system = db.Dialector.Name()
+	return registerCallbacks(db, "datahub:tracing", p.before, p.after)
+}
+
+func (p *sqlTracing) before(db *gorm.DB) {
+	ctx := db.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, span := tracer.Start(ctx, "sql", trace.WithSpanKind(trace.SpanKindClient))
+	db.InstanceSet(sqlSpanKey, span)
+}
+
+func (p *sqlTracing) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(sqlSpanKey)
+		if !ok {
+			return
+		}
+		span, ok := v.(trace.Span)
+		if !ok {
+			return
+		}
+		defer span.End()
+
+		table := db.Statement.Table
+		name := "sql " + operation
+		if table != "" {
+			name += " " + table
+		}
+		span.SetName(name)
+		span.SetAttributes(
+			semconv.DBSystemKey.String(p.system),
+			semconv.DBName(p.db),
+			semconv.DBOperation(operation),
+			semconv.DBSQLTable(table),
+			semconv.DBStatement(db.Statement.SQL.String()),
+			attribute.String("db.role", p.role),
+			attribute.Int64("db.rows_affected", db.RowsAffected),
+		)
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			span.RecordError(db.Error)
+			span.SetStatus(codes.Error, db.Error.Error())
+		}
+	}
+}
+
+// redisTracingHook 为每个 redis 命令创建 span，只记录命令名，不记录参数
+type redisTracingHook struct {
+	attrs []attribute.KeyValue
+}
+
+func newRedisTracingHook(db int32) redisTracingHook {
+	return redisTracingHook{attrs: []attribute.KeyValue{
+		semconv.DBSystemRedis,
+		semconv.DBRedisDBIndex(int(db)),
+	}}
+}
+
+func (h redisTracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h redisTracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := tracer.Start(ctx, "redis "+cmd.Name(),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(h.attrs...),
+			trace.WithAttributes(semconv.DBOperation(cmd.Name())),
+		)
+		defer span.End()
+		err := next(ctx, cmd)
+		recordRedisError(span, err)
+		return err
+	}
+}
+
+func (h redisTracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := tracer.Start(ctx, "redis pipeline",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(h.attrs...),
+			trace.WithAttributes(attribute.Int("db.redis.num_cmd", len(cmds))),
+		)
+		defer span.End()
+		err := next(ctx, cmds)
+		recordRedisError(span, err)
+		return err
+	}
+}
+
+// 缓存未命中返回的 redis.Nil 不是错误
+func recordRedisError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,93 @@
+package log
+
+import (
+	"context"
+	"datahub/pkg/global"
+	"datahub/pkg/md"
+	"net"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/peer"
+)
+
+// 日志中的 ECS 字段
+const (
+	TraceIdKey  = "trace.id"
+	ClientIpKey = "client.ip"
+	MethodKey   = "rpc.method"
+	DbKey       = "db.name"
+	TableKey    = "db.sql.table"
+)
+
+type requestKey struct{}
+
+// request 是日志中间件解析出的请求信息，供后续的日志使用
+type request struct {
+	method string
+	db     string
+	table  string
+}
+
+// WithRequest 把请求的方法、数据库和表保存到上下文中
+func WithRequest(ctx context.Context, method, db, table string) context.Context {
+	return context.WithValue(ctx, requestKey{}, &request{method: method, db: db, table: table})
+}
+
+func requestFrom(ctx context.Context) *request {
+	r, _ := ctx.Value(requestKey{}).(*request)
+	return r
+}
+
+// contextFields 返回从请求上下文中取值的字段，使用 Helper.WithContext(ctx) 打印时生效，
+// 没有值的字段不会输出
+func contextFields() []interface{} {
+	return []interface{}{
+		TraceIdKey, valuer(md.TraceId),
+		ClientIpKey, valuer(clientIp),
+		MethodKey, valuer(func(ctx context.Context) string {
+			if r := requestFrom(ctx); r != nil {
+				return r.method
+			}
+			return ""
+		}),
+		DbKey, valuer(func(ctx context.Context) string {
+			if r := requestFrom(ctx); r != nil {
+				return r.db
+			}
+			return ""
+		}),
+		TableKey, valuer(func(ctx context.Context) string {
+			if r := requestFrom(ctx); r != nil {
+				return r.table
+			}
+			return ""
+		}),
+	}
+}
+
+// 空字符串返回 nil，由 zapLogger 跳过
+func valuer(get func(context.Context) string) log.Valuer {
+	return func(ctx context.Context) interface{} {
+		if ctx == nil {
+			return nil
+		}
+		if v := get(ctx); v != "" {
+			return v
+		}
+		return nil
+	}
+}
+
+// 优先使用网关传入的 x-md-global-remoteip，没有时使用连接的对端地址
+func clientIp(ctx context.Context) string {
+	if ip := md.GetMetadata(ctx, global.RemoteIpMd); ip != "" {
+		return ip
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
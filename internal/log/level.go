@@ -0,0 +1,181 @@
+package log
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.uber.org/zap/zapcore"
+)
+
+// LoggerKey 是日志的 logger 名称字段，通过 log.With(logger, LoggerKey, "sql/main") 指定；
+// 未指定时使用打印日志的包名，如 data、server
+const LoggerKey = "log.logger"
+
+// Levels 保存可以在运行时修改的日志级别：一个全局级别和按 logger 名称覆盖的级别。
+// 名称按 "/" 分级匹配，"sql" 对 "sql/main" 等所有数据库生效
+type Levels struct {
+	mu    sync.Mutex // 串行修改
+	state atomic.Pointer[levelState]
+}
+
+type levelState struct {
+	root      zapcore.Level
+	overrides map[string]zapcore.Level
+}
+
+func NewLevels(level string, overrides map[string]string) (*Levels, error) {
+	l := &Levels{}
+	if err := l.Apply(level, overrides); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Apply 替换全局级别和全部覆盖，用于配置变更
+func (l *Levels) Apply(level string, overrides map[string]string) error {
+	root, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	state := &levelState{root: root, overrides: make(map[string]zapcore.Level, len(overrides))}
+	for name, v := range overrides {
+		lv, err := parseLevel(v)
+		if err != nil {
+			return fmt.Errorf("logger %s: %w", name, err)
+		}
+		state.overrides[name] = lv
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.state.Store(state)
+	return nil
+}
+
+// Set 修改一个 logger 的级别，name 为空时修改全局级别；level 为空时删除该 logger 的覆盖
+func (l *Levels) Set(name, level string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	old := l.state.Load()
+	state := &levelState{root: old.root, overrides: make(map[string]zapcore.Level, len(old.overrides)+1)}
+	for k, v := range old.overrides {
+		state.overrides[k] = v
+	}
+	switch {
+	case name == "":
+		lv, err := parseLevel(level)
+		if err != nil {
+			return err
+		}
+		state.root = lv
+	case level == "":
+		delete(state.overrides, name)
+	default:
+		lv, err := parseLevel(level)
+		if err != nil {
+			return err
+		}
+		state.overrides[name] = lv
+	}
+	l.state.Store(state)
+	return nil
+}
+
+// Snapshot 返回当前的全局级别和覆盖
+func (l *Levels) Snapshot() (string, map[string]string) {
+	state := l.state.Load()
+	overrides := make(map[string]string, len(state.overrides))
+	for name, lv := range state.overrides {
+		overrides[name] = lv.String()
+	}
+	return state.root.String(), overrides
+}
+
+// Enabled 判断名为 name 的 logger 是否输出 level 级别的日志
+func (l *Levels) Enabled(name string, level log.Level) bool {
+	return zapLevel(level) >= l.state.Load().levelOf(name)
+}
+
+func (s *levelState) levelOf(name string) zapcore.Level {
+	for name != "" {
+		if lv, ok := s.overrides[name]; ok {
+			return lv
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return s.root
+}
+
+// enabled 供 zapLogger 使用，没有覆盖时不需要确定 logger 名称
+func (l *Levels) enabled(level log.Level, keyvals []interface{}, skip int) bool {
+	state := l.state.Load()
+	lv := zapLevel(level)
+	if len(state.overrides) == 0 {
+		return lv >= state.root
+	}
+	name := ""
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == LoggerKey {
+			name, _ = keyvals[i+1].(string)
+			break
+		}
+	}
+	if name == "" {
+		name = callerPackage(skip + 1)
+	}
+	return lv >= state.levelOf(name)
+}
+
+// callerPackage 返回调用方所在包的最后一级名称，如 datahub/internal/data 返回 data
+func callerPackage(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+func parseLevel(level string) (zapcore.Level, error) {
+	if level == "" {
+		return zapcore.InfoLevel, nil
+	}
+	var lv zapcore.Level
+	if err := lv.UnmarshalText([]byte(strings.ToLower(level))); err != nil {
+		return lv, fmt.Errorf("invalid log level %q", level)
+	}
+	return lv, nil
+}
+
+func zapLevel(level log.Level) zapcore.Level {
+	switch level {
+	case log.LevelDebug:
+		return zapcore.DebugLevel
+	case log.LevelWarn:
+		return zapcore.WarnLevel
+	case log.LevelError:
+		return zapcore.ErrorLevel
+	case log.LevelFatal:
+		return zapcore.FatalLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
@@ -20,28 +20,50 @@ type Config struct {
 	Stdout     bool   `json:"stdout"`     // 是否同时输出到控制台
 }
 
-func NewLogger(conf *Config) log.Logger {
-	logger := initLogger(conf, 2)
-	return newZapLogger(logger)
+// NewLogger 创建应用日志，使用 Helper.WithContext(ctx) 打印时自动带上请求 ID、客户端 IP、方法、数据库和表。
+// 日志级别由 levels 决定，可以在运行时修改，conf.Level 不再生效
+func NewLogger(conf *Config, levels *Levels) log.Logger {
+	// log.With 多了一层调用
+	const skip = 3
+	logger := initLogger(conf, zapcore.DebugLevel, skip)
+	return log.With(newZapLogger(logger, levels, skip), contextFields()...)
 }
 
-func initLogger(conf *Config, skip int) *zap.Logger {
+// NewFileLogger 创建只写入 conf.Filename 的日志，忽略 Stdout，用于慢查询等单独的日志文件。
+// 返回的 cleanup 刷新缓冲并关闭日志文件
+func NewFileLogger(conf *Config) (log.Logger, func()) {
+	const skip = 2
+	rotator := newRotator(conf)
+	logger := newLogger(zapcore.AddSync(rotator), getZapLevel(conf.Level), skip)
+	return newZapLogger(logger, nil, skip), func() {
+		_ = logger.Sync()
+		_ = rotator.Close()
+	}
+}
+
+func initLogger(conf *Config, level zapcore.LevelEnabler, skip int) *zap.Logger {
 	// 1. 设置日志输出
 	var ws zapcore.WriteSyncer
 	if conf.Stdout {
 		ws = zapcore.AddSync(os.Stdout)
 	} else {
-		// 初始化 lumberjack
-		hook := lumberjack.Logger{
-			Filename:   conf.Filename,
-			MaxSize:    conf.MaxSize,
-			MaxBackups: conf.MaxBackups,
-			MaxAge:     conf.MaxAge,
-			Compress:   conf.Compress,
-		}
-		ws = zapcore.NewMultiWriteSyncer(zapcore.AddSync(os.Stdout), zapcore.AddSync(&hook))
+		ws = zapcore.NewMultiWriteSyncer(zapcore.AddSync(os.Stdout), zapcore.AddSync(newRotator(conf)))
 	}
+	return newLogger(ws, level, skip)
+}
+
+// 初始化 lumberjack
+func newRotator(conf *Config) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   conf.Filename,
+		MaxSize:    conf.MaxSize,
+		MaxBackups: conf.MaxBackups,
+		MaxAge:     conf.MaxAge,
+		Compress:   conf.Compress,
+	}
+}
 
+func newLogger(ws zapcore.WriteSyncer, level zapcore.LevelEnabler, skip int) *zap.Logger {
 	// 2. 创建 ecszap 的 EncoderConfig
 	encoderConfig := ecszap.NewDefaultEncoderConfig()
 
@@ -49,7 +71,7 @@ func initLogger(conf *Config, skip int) *zap.Logger {
 	core := ecszap.NewCore(
 		encoderConfig,
 		ws,
-		getZapLevel(conf.Level),
+		level,
 	)
 
 	// 4. 创建 zap logger
@@ -1,6 +1,8 @@
 package log
 
 import (
+	"fmt"
+
 	"github.com/go-kratos/kratos/v2/log"
 	"go.uber.org/zap"
 )
@@ -11,12 +13,16 @@ type zapLogger struct {
 	*zap.Logger
 	fields []zap.Field
 	Sync   func() error
+	levels *Levels // 为 nil 时只按 zap core 的级别过滤
+	skip   int     // 与 zap 的 caller skip 相同，用于确定调用方的包名
 }
 
-func newZapLogger(logger *zap.Logger) *zapLogger {
+func newZapLogger(logger *zap.Logger, levels *Levels, skip int) *zapLogger {
 	return &zapLogger{
 		Logger: logger,
 		Sync:   logger.Sync,
+		levels: levels,
+		skip:   skip,
 	}
 }
 
@@ -25,25 +31,38 @@ func (z *zapLogger) Log(level log.Level, keyvals ...interface{}) error {
 		z.Warn("Keyvalues must appear in pairs")
 		return nil
 	}
+	if z.levels != nil && !z.levels.enabled(level, keyvals, z.skip) {
+		return nil
+	}
 
+	// msg 作为日志的 message，其余作为字段；值为 nil 的字段不输出
+	var msg string
 	var data []zap.Field
 	for i := 0; i < len(keyvals); i += 2 {
-		data = append(data, zap.Any(keyvals[i].(string), keyvals[i+1]))
+		key, _ := keyvals[i].(string)
+		if key == log.DefaultMessageKey {
+			msg = fmt.Sprint(keyvals[i+1])
+			continue
+		}
+		if keyvals[i+1] == nil {
+			continue
+		}
+		data = append(data, zap.Any(key, keyvals[i+1]))
 	}
 
 	switch level {
 	case log.LevelDebug:
-		z.Debug("", data...)
+		z.Debug(msg, data...)
 	case log.LevelInfo:
-		z.Info("", data...)
+		z.Info(msg, data...)
 	case log.LevelWarn:
-		z.Warn("", data...)
+		z.Warn(msg, data...)
 	case log.LevelError:
-		z.Error("", data...)
+		z.Error(msg, data...)
 	case log.LevelFatal:
-		z.Fatal("", data...)
+		z.Fatal(msg, data...)
 	default:
-		z.Info("", data...)
+		z.Info(msg, data...)
 	}
 	return nil
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	v1 "datahub/api/datalayer/v1"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 需要管理 token 的 gRPC 服务
+var adminOperations = []string{
+	"/datalayer.v1.CacheAdmin/",
+	"/datalayer.v1.DatabaseAdmin/",
+}
+
+// AdminAuth 要求管理类 RPC 在 authorization 元数据中携带 "Bearer <token>"，与管理端 HTTP 使用同一个 token；
+// 未配置 token 时拒绝所有管理类 RPC
+func AdminAuth(token string) middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if token == "" {
+				return nil, errors.Forbidden(v1.ReasonAdminDisabled, "admin operations are disabled, set server.admin.token to enable them")
+			}
+			header := ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				header = tr.RequestHeader().Get("authorization")
+			}
+			if !validBearer(header, token) {
+				return nil, errors.Unauthorized(v1.ReasonUnauthorized, "invalid or missing admin token")
+			}
+			return handler(ctx, req)
+		}
+	}).Prefix(adminOperations...).Build()
+}
+
+// validBearer 检查 Authorization 头是否为 "Bearer <token>"
+func validBearer(header, token string) bool {
+	got, ok := strings.CutPrefix(header, "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
@@ -2,21 +2,34 @@ package server
 
 import (
 	"datahub/api/datalayer/v1"
+	"datahub/internal/biz"
 	"datahub/internal/conf"
 	"datahub/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-func NewGRPCServer(c *conf.Server, datalayer *service.DatalayerService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, datalayer *service.DatalayerService, cacheAdmin *service.CacheAdminService, databaseAdmin *service.DatabaseAdminService, health healthpb.HealthServer, databases biz.DatabaseRegistry, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
-			recovery.Recovery(),
+			// 从请求中提取上游的 trace 上下文并创建服务端 span
+			tracing.Server(),
+			// 日志需要 metadata 中的请求 ID 和客户端 IP
 			metadata.Server(),
+			// 放在 recovery 之前，panic 恢复后的错误也会被记录和统计
+			Logging(logger),
+			Metrics(databases),
+			// CacheAdmin 和 DatabaseAdmin 需要管理 token
+			AdminAuth(c.Admin.GetToken()),
+			recovery.Recovery(),
 		),
+		// 使用按依赖状态上报的健康检查，替换默认的
+		grpc.CustomHealth(),
 	}
 	if c.Grpc.Addr != "" {
 		opts = append(opts, grpc.Address(c.Grpc.Addr))
@@ -28,5 +41,8 @@ func NewGRPCServer(c *conf.Server, datalayer *service.DatalayerService, logger l
 	v1.RegisterDataCRUDServer(srv, datalayer)
 	v1.RegisterMetadataServer(srv, datalayer)
 	v1.RegisterRawSqlServer(srv, datalayer)
+	v1.RegisterCacheAdminServer(srv, cacheAdmin)
+	v1.RegisterDatabaseAdminServer(srv, databaseAdmin)
+	healthpb.RegisterHealthServer(srv, health)
 	return srv
 }
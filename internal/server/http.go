@@ -0,0 +1,87 @@
+package server
+
+import (
+	"datahub/internal/conf"
+	zaplog "datahub/internal/log"
+	"encoding/json"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// AdminServer 是管理端 HTTP 服务，与业务 gRPC 端口分开监听
+type AdminServer struct {
+	*http.Server
+}
+
+func NewAdminServer(c *conf.Server, levels *zaplog.Levels, logger log.Logger) *AdminServer {
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+		),
+	}
+	if c.Admin != nil && c.Admin.Addr != "" {
+		opts = append(opts, http.Address(c.Admin.Addr))
+	}
+	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/log/level", logLevelHandler(levels, c.Admin.GetToken(), log.NewHelper(logger)))
+	return &AdminServer{Server: srv}
+}
+
+type logLevels struct {
+	Level     string            `json:"level"`
+	Overrides map[string]string `json:"overrides"`
+}
+
+type setLogLevelRequest struct {
+	// 为空时修改全局级别
+	Logger string `json:"logger"`
+	// 为空时删除 logger 的覆盖
+	Level string `json:"level"`
+}
+
+// logLevelHandler 查看和修改运行时的日志级别：GET 返回当前级别，PUT 修改全局或单个 logger 的级别。
+// 修改需要携带 token，未配置 token 时禁止修改。配置文件中的 log 变化时会覆盖这里的修改
+func logLevelHandler(levels *zaplog.Levels, token string, helper *log.Helper) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.Method {
+		case nethttp.MethodGet:
+		case nethttp.MethodPut, nethttp.MethodPost:
+			if token == "" {
+				nethttp.Error(w, "changing log levels is disabled, set server.admin.token to enable it", nethttp.StatusForbidden)
+				return
+			}
+			if !validBearer(r.Header.Get("Authorization"), token) {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				nethttp.Error(w, "unauthorized", nethttp.StatusUnauthorized)
+				return
+			}
+			var req setLogLevelRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				nethttp.Error(w, "invalid request body: "+err.Error(), nethttp.StatusBadRequest)
+				return
+			}
+			if req.Logger == "" && req.Level == "" {
+				nethttp.Error(w, "level is required", nethttp.StatusBadRequest)
+				return
+			}
+			if err := levels.Set(req.Logger, req.Level); err != nil {
+				nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+				return
+			}
+			helper.WithContext(r.Context()).Infof("log level of logger %q set to %q", req.Logger, req.Level)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			nethttp.Error(w, "method not allowed", nethttp.StatusMethodNotAllowed)
+			return
+		}
+
+		level, overrides := levels.Snapshot()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&logLevels{Level: level, Overrides: overrides})
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	zaplog "datahub/internal/log"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/status"
+)
+
+// Logging 把方法、数据库和表保存到请求上下文中，之后用 Helper.WithContext(ctx) 打印的日志都会带上这些字段；
+// 请求结束后为每个 RPC 打印一行访问日志，包含耗时和状态
+func Logging(logger log.Logger) middleware.Middleware {
+	helper := log.NewHelper(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			method := ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				method = tr.Operation()
+			}
+			db, table := requestTarget(req)
+			ctx = zaplog.WithRequest(ctx, method, db, table)
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			latency := time.Since(start)
+
+			level := log.LevelInfo
+			keyvals := []interface{}{
+				"msg", "access",
+				"event.duration", latency.Nanoseconds(),
+				"rpc.grpc.status_code", status.Code(err).String(),
+			}
+			if err != nil {
+				e := errors.FromError(err)
+				keyvals = append(keyvals, "event.outcome", "failure", "error.code", e.Reason, "error.message", e.Message)
+				// 服务端错误记为 error，调用方的错误记为 warn
+				level = log.LevelWarn
+				if e.Code >= 500 {
+					level = log.LevelError
+				}
+			} else {
+				keyvals = append(keyvals, "event.outcome", "success")
+			}
+			helper.WithContext(ctx).Log(level, keyvals...)
+			return reply, err
+		}
+	}
+}
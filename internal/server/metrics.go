@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	v1 "datahub/api/datalayer/v1"
+	"datahub/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "datahub_rpc_requests_total",
+		Help: "RPCs handled by method, database, table, status code and error reason.",
+	}, []string{"method", "db", "table", "code", "reason"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "datahub_rpc_duration_seconds",
+		Help:    "RPC latency by method and database.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "db"})
+)
+
+// 未配置的数据库、不存在的表等客户端任意传入的名称统一记为 other，避免标签取值无限增长
+const otherLabel = "other"
+
+func init() {
+	prometheus.MustRegister(rpcRequests, rpcDuration)
+}
+
+// Metrics 按方法、数据库和表统计请求次数、耗时和错误原因。
+// 只有已配置的数据库和实际存在的表使用原名
+func Metrics(databases biz.DatabaseRegistry) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			method := ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				method = tr.Operation()
+			}
+			db, table := requestTarget(req)
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+
+			code, reason := "OK", ""
+			if err != nil {
+				code = status.Code(err).String()
+				reason = errors.FromError(err).Reason
+			}
+			if db != "" && !databases.HasDatabase(db) {
+				db = otherLabel
+			}
+			if table != "" && !databases.HasTable(db, table) {
+				table = otherLabel
+			}
+			rpcRequests.WithLabelValues(method, db, table, code, reason).Inc()
+			rpcDuration.WithLabelValues(method, db).Observe(time.Since(start).Seconds())
+			return reply, err
+		}
+	}
+}
+
+// requestTarget 返回请求访问的数据库和表，请求中没有时为空
+func requestTarget(req interface{}) (string, string) {
+	switch r := req.(type) {
+	case interface{ GetTable() *v1.TableSchema }:
+		return r.GetTable().GetDbName(), r.GetTable().GetTableName()
+	case interface {
+		GetDbName() string
+		GetTableName() string
+	}:
+		return r.GetDbName(), r.GetTableName()
+	case interface{ GetDbName() string }:
+		return r.GetDbName(), ""
+	case interface{ GetDb() string }:
+		return r.GetDb(), ""
+	}
+	return "", ""
+}
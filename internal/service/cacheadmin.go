@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"datahub/api/datalayer/v1"
+	"datahub/internal/biz"
+)
+
+type CacheAdminService struct {
+	v1.UnimplementedCacheAdminServer
+	uc *biz.CacheAdminUseCase
+}
+
+func NewCacheAdminService(uc *biz.CacheAdminUseCase) *CacheAdminService {
+	return &CacheAdminService{uc: uc}
+}
+
+func (s *CacheAdminService) InspectCache(ctx context.Context, req *v1.InspectCacheRequest) (*v1.InspectCacheResponse, error) {
+	return s.uc.InspectCache(ctx, req)
+}
+
+func (s *CacheAdminService) EvictCache(ctx context.Context, req *v1.EvictCacheRequest) (*v1.EvictCacheResponse, error) {
+	return s.uc.EvictCache(ctx, req)
+}
+
+func (s *CacheAdminService) FlushCache(ctx context.Context, req *v1.FlushCacheRequest) (*v1.FlushCacheResponse, error) {
+	return s.uc.FlushCache(ctx, req)
+}
+
+func (s *CacheAdminService) WarmCache(ctx context.Context, req *v1.WarmCacheRequest) (*v1.WarmCacheResponse, error) {
+	return s.uc.WarmCache(ctx, req)
+}
+
+func (s *CacheAdminService) GetCacheStats(ctx context.Context, req *v1.GetCacheStatsRequest) (*v1.GetCacheStatsResponse, error) {
+	return s.uc.GetCacheStats(ctx, req)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"datahub/api/datalayer/v1"
+	"datahub/internal/biz"
+)
+
+type DatabaseAdminService struct {
+	v1.UnimplementedDatabaseAdminServer
+	uc *biz.DatabaseAdminUseCase
+}
+
+func NewDatabaseAdminService(uc *biz.DatabaseAdminUseCase) *DatabaseAdminService {
+	return &DatabaseAdminService{uc: uc}
+}
+
+func (s *DatabaseAdminService) GetPoolStats(ctx context.Context, req *v1.GetPoolStatsRequest) (*v1.GetPoolStatsResponse, error) {
+	return s.uc.GetPoolStats(ctx, req)
+}
@@ -47,6 +47,10 @@ func (s *DatalayerService) RollbackTransaction(ctx context.Context, req *v1.Tran
 	return s.uc.RollbackTransaction(ctx, req)
 }
 
+func (s *DatalayerService) ListDatabases(ctx context.Context, req *v1.ListDatabasesRequest) (*v1.ListDatabasesResponse, error) {
+	return s.uc.ListDatabases(ctx, req)
+}
+
 func (s *DatalayerService) ListTables(ctx context.Context, req *v1.ListTablesRequest) (*v1.ListTablesResponse, error) {
 	return s.uc.ListTables(ctx, req)
 }
@@ -0,0 +1,20 @@
+package md
+
+import (
+	"context"
+	"datahub/pkg/global"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceId 返回日志中使用的请求标识：优先使用调用方传入的 x-md-global-requestid，
+// 没有时使用 OpenTelemetry 的 trace ID，便于在链路追踪中查找
+func TraceId(ctx context.Context) string {
+	if id := GetMetadata(ctx, global.RequestIdMd); id != "" {
+		return id
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}